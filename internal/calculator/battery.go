@@ -0,0 +1,150 @@
+package calculator
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+	"math"
+)
+
+const (
+	defaultBatteryAgingPerYear      = 1.0 // % state of health per year
+	defaultBatteryAgingPer100k      = 4.0 // % state of health per 100.000 km
+	defaultBatteryReplacementHealth = 70.0
+)
+
+// hasTractionBattery reports whether the profile drives electrically with a
+// battery of known size.
+func hasTractionBattery(profile *models.CarProfile) bool {
+	return profile.BatterySize > 0 && profile.ElectricConsumption > 0
+}
+
+// agedBatteryExtraEnergy is the additional grid energy in % per % of state
+// of health lost since the purchase. Aged cells have a higher internal
+// resistance and lose more energy while charging and driving.
+const agedBatteryExtraEnergy = 0.25
+
+// batteryState is the state of health in % of the battery, carried forward
+// month by month over the ownership period.
+type batteryState struct {
+	health      float64
+	startHealth float64 // at purchase
+	monthlyLoss float64
+	threshold   float64
+	replace     bool
+}
+
+// newBatteryState returns the state of health at purchase. The battery ages
+// linearly with time since the first registration and with the distance
+// driven, including the odometer reading at purchase.
+func newBatteryState(profile *models.CarProfile) *batteryState {
+	agingPerYear := profile.BatteryAgingPerYear
+	if agingPerYear <= 0 {
+		agingPerYear = defaultBatteryAgingPerYear
+	}
+	agingPer100k := profile.BatteryAgingPer100k
+	if agingPer100k <= 0 {
+		agingPer100k = defaultBatteryAgingPer100k
+	}
+	threshold := profile.BatteryReplacementHealth
+	if threshold <= 0 {
+		threshold = defaultBatteryReplacementHealth
+	}
+
+	health := math.Max(100-agingPerYear*vehicleAgeYears(profile)-agingPer100k*profile.OdometerAtPurchase/100000, 0)
+	return &batteryState{
+		health:      health,
+		startHealth: health,
+		monthlyLoss: agingPerYear/12 + agingPer100k*profile.MonthlyKilometers/100000,
+		threshold:   threshold,
+		replace:     profile.BatteryReplacementCost > 0,
+	}
+}
+
+// newProjectionBattery returns the battery state for projectMonth, nil
+// without a traction battery.
+func newProjectionBattery(profile *models.CarProfile) *batteryState {
+	if !hasTractionBattery(profile) {
+		return nil
+	}
+	return newBatteryState(profile)
+}
+
+// advance ages the battery by one month and reports whether it is replaced
+// in that month. Once the state of health falls below the threshold, a
+// battery with a replacement cost is replaced and starts again at 100%.
+func (s *batteryState) advance() bool {
+	s.health = math.Max(s.health-s.monthlyLoss, 0)
+	if s.replace && s.health < s.threshold {
+		s.health = 100
+		return true
+	}
+	return false
+}
+
+// extraEnergyFactor returns the factor on the grid energy of the aged
+// battery relative to the battery at purchase.
+func (s *batteryState) extraEnergyFactor() float64 {
+	return 1 + agedBatteryExtraEnergy*(s.startHealth-s.health)/100
+}
+
+// electricShareLoss returns the share (0-1) of the monthly kilometers a
+// plug-in hybrid drives on fuel instead of electrically because its aged
+// battery covers less distance per charge. A share derived from the commute
+// is derived again with the aged capacity, an entered or default share
+// drops with the range relative to the purchase.
+func (c *Calculator) electricShareLoss(profile *models.CarProfile, battery *batteryState) float64 {
+	if !isPlugInHybrid(profile) {
+		return 0
+	}
+
+	if nominal, ok := commuteElectricShare(profile); ok && !hasElectricShare(profile) {
+		aged := *profile
+		aged.BatterySize = profile.BatterySize * battery.health / 100
+		share, _ := commuteElectricShare(&aged)
+		return math.Max(nominal-share, 0)
+	}
+
+	if battery.startHealth <= 0 {
+		return 0
+	}
+	nominal := c.calculateElectricShare(profile)
+	return math.Max(nominal*(1-battery.health/battery.startHealth), 0)
+}
+
+// calculateBatteryHealth records the state of health at the end of each
+// year of ownership and the months in which the battery is replaced.
+func (c *Calculator) calculateBatteryHealth(profile *models.CarProfile, calc *models.CostCalculation) {
+	if !hasTractionBattery(profile) {
+		return
+	}
+
+	battery := newBatteryState(profile)
+	for month := 1; month <= profile.ExpectedYearsOfOwnership*12; month++ {
+		battery.advance()
+		if month%12 == 0 {
+			calc.BatteryHealth = append(calc.BatteryHealth, battery.health)
+		}
+	}
+	for _, month := range calc.Timeline {
+		if month.Battery > 0 {
+			calc.BatteryReplacementMonths = append(calc.BatteryReplacementMonths, month.Month)
+		}
+	}
+}
+
+func validateBattery(profile *models.CarProfile) []string {
+	var errors []string
+
+	if profile.BatteryAgingPerYear < 0 || profile.BatteryAgingPer100k < 0 {
+		errors = append(errors, "Batteriealterung muss >= 0 sein")
+	}
+
+	if profile.BatteryReplacementCost < 0 {
+		errors = append(errors, "Kosten für den Batterietausch müssen >= 0 sein")
+	}
+
+	if profile.BatteryReplacementHealth < 0 || profile.BatteryReplacementHealth >= 100 {
+		errors = append(errors, "Batteriezustand für den Tausch muss zwischen 0 und 100% liegen")
+	}
+
+	return errors
+}
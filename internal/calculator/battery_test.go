@@ -0,0 +1,94 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+
+	"auto-unterhaltsrechner/internal/models"
+)
+
+func TestBatteryState(t *testing.T) {
+	tests := []struct {
+		name            string
+		profile         models.CarProfile
+		months          int
+		wantStartHealth float64
+		wantHealth      float64
+		wantReplaced    int // month of the first replacement, 0 for none
+	}{
+		// 1% per year + 4% per 100.000 km at 1000 km per month
+		{"new car after one year", models.CarProfile{MonthlyKilometers: 1000}, 12, 100, 98.52, 0},
+		// 100 - 4 years × 1% - 60.000 km × 4% ÷ 100.000
+		{"used car at purchase", models.CarProfile{MonthlyKilometers: 1000, OdometerAtPurchase: 60000,
+			FirstRegistration: ownershipStart().AddDate(-4, 0, 0)}, 0, 93.6, 93.6, 0},
+		// 0,1633% per month falls below 85% in month 92
+		{"replacement", models.CarProfile{MonthlyKilometers: 2000, BatteryReplacementCost: 10000,
+			BatteryReplacementHealth: 85}, 92, 100, 100, 92},
+		{"no replacement without cost", models.CarProfile{MonthlyKilometers: 2000,
+			BatteryReplacementHealth: 85}, 92, 100, 84.97, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			battery := newBatteryState(&tt.profile)
+			if math.Abs(battery.startHealth-tt.wantStartHealth) > 0.01 {
+				t.Errorf("start health = %.2f, want %.2f", battery.startHealth, tt.wantStartHealth)
+			}
+
+			var replaced int
+			for month := 1; month <= tt.months; month++ {
+				if battery.advance() && replaced == 0 {
+					replaced = month
+				}
+			}
+			if math.Abs(battery.health-tt.wantHealth) > 0.01 {
+				t.Errorf("health after %d months = %.2f, want %.2f", tt.months, battery.health, tt.wantHealth)
+			}
+			if replaced != tt.wantReplaced {
+				t.Errorf("replaced in month %d, want %d", replaced, tt.wantReplaced)
+			}
+		})
+	}
+}
+
+func TestBatteryAgingCosts(t *testing.T) {
+	noCO2 := models.DefaultAssumptions()
+	noCO2.CO2Prices = map[int]float64{}
+
+	tests := []struct {
+		name            string
+		profile         models.CarProfile
+		wantFuelRatio   float64 // month 121 ÷ month 1, same calendar month
+		wantEnergyRatio float64
+	}{
+		// (1 + 0,25% × 14,92) ÷ (1 + 0,25% × 0,12)
+		{"electric car needs more energy", models.CarProfile{ElectricConsumption: 20, BatterySize: 60,
+			ElectricityPrice: 0.3}, 0, 1.0370},
+		// 40% share drops with the state of health, the remaining electric
+		// km need more energy
+		{"plug-in hybrid with entered share", models.CarProfile{FuelConsumption: 6, FuelPrice: 1.8,
+			ElectricConsumption: 20, BatterySize: 12, ElectricityPrice: 0.3, ElectricShare: 40}, 1.0986, 0.8833},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := tt.profile
+			profile.MonthlyKilometers = 1000
+			profile.ExpectedYearsOfOwnership = 11
+
+			c := New()
+			c.SetAssumptions(noCO2)
+			calc := c.CalculateCosts(&profile)
+
+			first, aged := calc.Timeline[0], calc.Timeline[120]
+			if first.Fuel > 0 {
+				if got := aged.Fuel / first.Fuel; math.Abs(got-tt.wantFuelRatio) > 1e-4 {
+					t.Errorf("fuel month 121 ÷ month 1 = %.4f, want %.4f", got, tt.wantFuelRatio)
+				}
+			}
+			if got := aged.Electricity / first.Electricity; math.Abs(got-tt.wantEnergyRatio) > 1e-4 {
+				t.Errorf("electricity month 121 ÷ month 1 = %.4f, want %.4f", got, tt.wantEnergyRatio)
+			}
+		})
+	}
+}
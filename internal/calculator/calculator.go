@@ -5,86 +5,251 @@ import (
 	"math"
 )
 
-type Calculator struct{}
+type Calculator struct {
+	assumptions models.Assumptions
+
+	// carTaxFactor scales the Kfz-Steuer, entered or calculated
+	carTaxFactor float64
+}
 
 func New() *Calculator {
-	return &Calculator{}
+	return &Calculator{
+		assumptions:  models.DefaultAssumptions(),
+		carTaxFactor: 1,
+	}
+}
+
+// SetAssumptions replaces the shared calculation parameters.
+func (c *Calculator) SetAssumptions(assumptions models.Assumptions) {
+	c.assumptions = assumptions
 }
 
 func (c *Calculator) CalculateCosts(profile *models.CarProfile) *models.CostCalculation {
+	return c.calculateCosts(profile, 1)
+}
+
+// calculateCosts calculates the profile with its residual values scaled by
+// residualFactor, so that a varied resale value also changes the capital
+// cost and the present value.
+func (c *Calculator) calculateCosts(profile *models.CarProfile, residualFactor float64) *models.CostCalculation {
 	if profile == nil {
 		return nil
 	}
 
+	// Derive kilometers and consumption from the driving profile, the
+	// calculation continues with the derived profile
+	profile, drivingMix := c.applyDrivingProfile(profile)
 	calc := &models.CostCalculation{
-		Profile: profile,
+		Profile:    profile,
+		DrivingMix: drivingMix,
 	}
 
+	// Split the distance of plug-in hybrids between fuel and electric driving
+	fuelKm, electricKm := c.splitKilometers(profile)
+	calc.ElectricShare = c.calculateElectricShare(profile) * 100
+
 	// Calculate fuel costs
-	calc.MonthlyFuelCost = c.calculateMonthlyFuelCost(profile)
+	calc.MonthlyFuelAmount = c.calculateMonthlyFuelAmount(profile, fuelKm)
+	calc.MonthlyFuelCost = calc.MonthlyFuelAmount * profile.FuelPrice
 	calc.AnnualFuelCost = calc.MonthlyFuelCost * 12
 
-	// Calculate electricity costs
-	calc.MonthlyElectricityCost = c.calculateMonthlyElectricityCost(profile)
+	// Calculate electricity costs over the charging mix, including the
+	// losses between the grid and the battery
+	calc.MonthlyElectricityAmount = c.calculateMonthlyElectricityAmount(profile, electricKm)
+	calc.MonthlyGridEnergy, calc.BlendedElectricityPrice, calc.ChargingBreakdown =
+		c.calculateChargingCosts(profile, calc.MonthlyElectricityAmount)
+	calc.MonthlyElectricityCost = calc.MonthlyGridEnergy * calc.BlendedElectricityPrice
 	calc.AnnualElectricityCost = calc.MonthlyElectricityCost * 12
 
-	// Calculate total running costs
-	monthlyTax := profile.AnnualCarTax / 12
-	monthlyInsurance := profile.AnnualCarInsurance / 12
-	calc.MonthlyRunningCosts = calc.MonthlyFuelCost + calc.MonthlyElectricityCost +
-		monthlyTax + monthlyInsurance + profile.FinancingRate
+	// Calculate CO2 emissions from fuel and grid energy
+	c.calculateEmissions(profile, calc)
+
+	// Calculate maintenance and wear costs
+	c.calculateMaintenanceCosts(profile, calc)
+
+	// Calculate user-defined custom costs
+	c.calculateCustomCosts(profile, calc)
+
+	// Model the financing as a loan if loan parameters are given, a leased
+	// car only costs the special payment upfront
+	calc.UpfrontCosts = profile.PurchasePrice
+	if usesLoan(profile) {
+		calc.UpfrontCosts = profile.DownPayment
+		calc.LoanAmount, calc.AmortizationSchedule = c.calculateAmortizationSchedule(profile)
+	} else if usesLease(profile) {
+		calc.UpfrontCosts = profile.LeaseSpecialPayment
+		calc.LeaseExcessKilometers, calc.LeaseSettlement = c.calculateLeaseSettlement(profile)
+	}
+
+	// One-off custom costs are paid at purchase, subsidies and dealer
+	// bonuses are credited at purchase
+	calc.UpfrontCosts += calc.OneOffCustomCost
+	calc.PurchaseIncentives = profile.PurchaseIncentives
+	calc.UpfrontCosts -= calc.PurchaseIncentives
+
+	// Recurring revenues like the THG-Quote reduce the running costs
+	calc.MonthlyRevenue = profile.AnnualTHGRevenue / 12
+
+	// Calculate the Kfz-Steuer, electric cars may lose their exemption
+	// during the ownership period
+	calc.MonthlyTaxCost = c.monthlyTax(profile, 1)
+	calc.AnnualTaxCost = calc.MonthlyTaxCost * 12
+	if profile.AutoCarTax {
+		calc.TaxExemptUntil = CarTaxExemptUntil(profile)
+	}
+
+	// Build the month-by-month timeline, financing stops after FinancingPeriod
+	calc.FinancingMonths = c.calculateFinancingMonths(profile)
+	calc.Timeline = c.buildTimeline(profile, calc)
+	calc.YearlyProjection = calculateYearlyProjection(calc.Timeline)
+	sumTimelineEmissions(calc)
+	calc.InsurancePremiums = c.calculateInsurancePremiums(profile)
+	calc.SeasonalProfile = c.calculateSeasonalProfile(profile, calc)
+	c.calculateBatteryHealth(profile, calc)
+	if len(calc.AmortizationSchedule) > 0 {
+		for _, payment := range calc.AmortizationSchedule[:calc.FinancingMonths] {
+			calc.TotalInterest += payment.Interest
+		}
+	}
+
+	// Calculate running costs during and after financing
+	monthlyInsurance := insurancePremium(profile, 1).Premium / 12
+	calc.MonthlyCostsAfterFinancing = calc.MonthlyFuelCost + calc.MonthlyElectricityCost +
+		calc.MonthlyTaxCost + monthlyInsurance + calc.MonthlyMaintenanceCost + calc.MonthlyCustomCost -
+		calc.MonthlyRevenue
+	if calc.FinancingMonths > 0 {
+		calc.MonthlyFinancingCost = c.monthlyFinancing(profile, calc, 1)
+	}
+	calc.MonthlyRunningCosts = calc.MonthlyCostsAfterFinancing + calc.MonthlyFinancingCost
+
+	// Annual figures cover the first year of the timeline, the monthly
+	// running and financing costs are its average month so that both add up
 	calc.AnnualRunningCosts = calc.MonthlyRunningCosts * 12
+	calc.AnnualFinancingCost = calc.MonthlyFinancingCost * 12
+	calc.AnnualRevenue = calc.MonthlyRevenue * 12
+	if len(calc.Timeline) >= 12 {
+		calc.AnnualRunningCosts = 0
+		calc.AnnualFinancingCost = 0
+		calc.AnnualTaxCost = 0
+		calc.AnnualRevenue = 0
+		calc.AnnualFuelCost = 0
+		calc.AnnualElectricityCost = 0
+		calc.AnnualInsuranceCost = 0
+		for _, month := range calc.Timeline[:12] {
+			calc.AnnualFuelCost += month.Fuel
+			calc.AnnualElectricityCost += month.Electricity
+			calc.AnnualInsuranceCost += month.Insurance
+			calc.AnnualRunningCosts += month.Total
+			calc.AnnualFinancingCost += month.Financing
+			calc.AnnualTaxCost += month.Tax
+			calc.AnnualRevenue += month.Revenue
+		}
+		calc.MonthlyCostsAfterFinancing = (calc.AnnualRunningCosts - calc.AnnualFinancingCost) / 12
+		calc.MonthlyFinancingCost = calc.AnnualFinancingCost / 12
+		calc.MonthlyRunningCosts = calc.MonthlyCostsAfterFinancing + calc.MonthlyFinancingCost
+	}
 
-	// Calculate depreciation
-	calc.TotalDepreciation = c.calculateDepreciation(profile)
-	calc.AnnualDepreciation = calc.TotalDepreciation / float64(profile.ExpectedYearsOfOwnership)
+	// Calculate the taxable benefit of a company car
+	c.calculateCompanyCarBenefit(profile, calc)
+
+	// Calculate depreciation with the selected model from the age and the
+	// odometer reading of the car, a leased car is returned at the end of
+	// the lease
+	c.calculateVehicleAge(profile, calc)
+	if !usesLease(profile) {
+		calc.ResidualValues = c.calculateResidualValues(profile)
+		for i := range calc.ResidualValues {
+			calc.ResidualValues[i] *= residualFactor
+		}
+		if len(calc.ResidualValues) > 0 {
+			calc.ResidualValue = calc.ResidualValues[len(calc.ResidualValues)-1]
+			calc.TotalDepreciation = profile.PurchasePrice - calc.ResidualValue
+			calc.AnnualDepreciation = calc.TotalDepreciation / float64(profile.ExpectedYearsOfOwnership)
+		}
+	}
 
-	// Calculate cost per kilometer
-	annualKm := profile.MonthlyKilometers * 12
-	if annualKm > 0 {
-		calc.CostPerKilometer = (calc.AnnualRunningCosts + calc.AnnualDepreciation) / annualKm
+	// Calculate total cost of ownership from the payments made at purchase
+	// and over the timeline, less the value of the car when it is sold
+	var totalRunningCosts float64
+	if len(calc.Timeline) > 0 {
+		totalRunningCosts = calc.Timeline[len(calc.Timeline)-1].Cumulative
+	}
+	calc.NominalCostOfOwnership = calc.UpfrontCosts + totalRunningCosts - calc.ResidualValue
+
+	// Discount the payments to compare cash purchase, loan and lease fairly,
+	// optionally charging the interest forgone on the money spent
+	c.calculatePresentValue(calc)
+	calc.TotalCostOfOwnership = calc.NominalCostOfOwnership
+	if c.assumptions.IncludeCapitalCost {
+		calc.TotalCostOfOwnership += calc.CapitalCost
 	}
 
-	// Calculate total cost of ownership
-	totalYears := float64(profile.ExpectedYearsOfOwnership)
-	calc.TotalCostOfOwnership = (calc.AnnualRunningCosts * totalYears) + calc.TotalDepreciation
+	// Calculate cost per kilometer over the whole ownership period
+	totalKm := profile.MonthlyKilometers * float64(len(calc.Timeline))
+	if totalKm > 0 {
+		calc.CostPerKilometer = calc.TotalCostOfOwnership / totalKm
+	}
 
 	return calc
 }
 
-func (c *Calculator) calculateMonthlyFuelCost(profile *models.CarProfile) float64 {
-	if profile.FuelConsumption <= 0 || profile.MonthlyKilometers <= 0 {
+// calculateFinancingMonths returns how many months of the ownership period
+// the financing rate is paid. Without a financing period the rate is
+// assumed to run for the whole ownership period.
+func (c *Calculator) calculateFinancingMonths(profile *models.CarProfile) int {
+	ownershipMonths := profile.ExpectedYearsOfOwnership * 12
+	switch {
+	case usesLoan(profile):
+		return max(min(profile.FinancingPeriod, ownershipMonths), 0)
+	case usesLease(profile):
+		return leaseMonths(profile)
+	case profile.AcquisitionType == models.AcquisitionCash, profile.FinancingRate <= 0:
 		return 0
 	}
 
-	// Fuel consumption per 100km * monthly km / 100 * fuel price
-	return (profile.FuelConsumption * profile.MonthlyKilometers / 100) * profile.FuelPrice
+	if profile.FinancingPeriod <= 0 || profile.FinancingPeriod > ownershipMonths {
+		return ownershipMonths
+	}
+	return profile.FinancingPeriod
 }
 
-func (c *Calculator) calculateMonthlyElectricityCost(profile *models.CarProfile) float64 {
-	if profile.ElectricConsumption <= 0 || profile.MonthlyKilometers <= 0 {
-		return 0
+func (c *Calculator) buildTimeline(profile *models.CarProfile, calc *models.CostCalculation) []models.MonthlyCost {
+	months := profile.ExpectedYearsOfOwnership * 12
+	if months <= 0 {
+		return nil
+	}
+
+	timeline := make([]models.MonthlyCost, 0, months)
+	battery := newProjectionBattery(profile)
+	var cumulative float64
+	for month := 1; month <= months; month++ {
+		entry := c.projectMonth(profile, calc, month, battery)
+		entry.Financing = c.monthlyFinancing(profile, calc, month)
+		entry.Total += entry.Financing
+		cumulative += entry.Total
+		entry.Cumulative = cumulative
+		timeline = append(timeline, entry)
 	}
 
-	// Electric consumption per 100km * monthly km / 100 * electricity price
-	return (profile.ElectricConsumption * profile.MonthlyKilometers / 100) * profile.ElectricityPrice
+	return timeline
 }
 
-func (c *Calculator) calculateDepreciation(profile *models.CarProfile) float64 {
-	if profile.PurchasePrice <= 0 || profile.ExpectedYearsOfOwnership <= 0 {
+func (c *Calculator) calculateMonthlyFuelAmount(profile *models.CarProfile, km float64) float64 {
+	if profile.FuelConsumption <= 0 || km <= 0 {
 		return 0
 	}
 
-	// Simple linear depreciation - assumes 20% residual value after ownership period
-	residualValuePercentage := 0.20
+	// Fuel consumption per 100km * km driven on fuel / 100
+	return profile.FuelConsumption * km / 100
+}
 
-	// For cars older than 10 years, depreciation slows down
-	if profile.ExpectedYearsOfOwnership > 10 {
-		residualValuePercentage = 0.10
+func (c *Calculator) calculateMonthlyElectricityAmount(profile *models.CarProfile, km float64) float64 {
+	if profile.ElectricConsumption <= 0 || km <= 0 {
+		return 0
 	}
 
-	residualValue := profile.PurchasePrice * residualValuePercentage
-	return profile.PurchasePrice - residualValue
+	// Electric consumption per 100km * km driven electrically / 100
+	return profile.ElectricConsumption * km / 100
 }
 
 func (c *Calculator) CalculateBreakEven(electricProfile, combustionProfile *models.CarProfile) *BreakEvenAnalysis {
@@ -102,28 +267,68 @@ func (c *Calculator) CalculateBreakEven(electricProfile, combustionProfile *mode
 		CombustionCosts:   combustionCalc,
 	}
 
-	// Calculate break-even point
-	priceDifference := electricProfile.PurchasePrice - combustionProfile.PurchasePrice
-	monthlySavings := combustionCalc.MonthlyRunningCosts - electricCalc.MonthlyRunningCosts
-
-	if monthlySavings > 0 {
-		analysis.BreakEvenMonths = int(math.Ceil(priceDifference / monthlySavings))
-		analysis.BreakEvenKilometers = float64(analysis.BreakEvenMonths) * electricProfile.MonthlyKilometers
-	} else {
-		analysis.BreakEvenMonths = -1 // Never breaks even
+	// Cumulative costs per month for charting, covering the longer ownership
+	// period and the break-even month if it lies beyond it
+	months := electricProfile.ExpectedYearsOfOwnership * 12
+	if combustionMonths := combustionProfile.ExpectedYearsOfOwnership * 12; combustionMonths > months {
+		months = combustionMonths
+	}
+	chartMonths := max(months, maxBreakEvenChartMonths)
+	electricCosts := c.cumulativeCosts(electricCalc, chartMonths)
+	combustionCosts := c.cumulativeCosts(combustionCalc, chartMonths)
+
+	// Calculate break-even point as the first month in which the electric
+	// vehicle has cost less in total than the combustion vehicle
+	analysis.BreakEvenMonths = -1 // Never breaks even
+	for month := range electricCosts {
+		if electricCosts[month] <= combustionCosts[month] {
+			analysis.BreakEvenMonths = month
+			analysis.BreakEvenKilometers = float64(month) * electricCalc.Profile.MonthlyKilometers
+			break
+		}
+	}
+	if analysis.BreakEvenMonths > months {
+		months = min(analysis.BreakEvenMonths+12, maxBreakEvenChartMonths)
 	}
 
 	// Calculate total savings over ownership period
-	totalMonths := float64(electricProfile.ExpectedYearsOfOwnership * 12)
-	if monthlySavings > 0 {
-		analysis.TotalSavings = (monthlySavings * totalMonths) - priceDifference
-	} else {
-		analysis.TotalSavings = (monthlySavings * totalMonths) - priceDifference
+	totalMonths := electricProfile.ExpectedYearsOfOwnership * 12
+	if totalMonths >= 0 && totalMonths <= chartMonths {
+		analysis.TotalSavings = combustionCosts[totalMonths] - electricCosts[totalMonths]
 	}
 
+	analysis.ElectricCumulativeCosts = electricCosts[:months+1]
+	analysis.CombustionCumulativeCosts = combustionCosts[:months+1]
+
 	return analysis
 }
 
+// maxBreakEvenChartMonths limits how far the cumulative cost series are
+// extended beyond the ownership period to reach a late break-even point.
+const maxBreakEvenChartMonths = 30 * 12
+
+// cumulativeCosts returns the accumulated costs at the end of each month,
+// starting with the upfront costs at month 0. Months beyond the timeline
+// continue with the projected running costs without financing.
+func (c *Calculator) cumulativeCosts(calc *models.CostCalculation, months int) []float64 {
+	costs := make([]float64, months+1)
+	costs[0] = calc.UpfrontCosts
+	battery := newProjectionBattery(calc.Profile)
+	for month := 1; month <= months; month++ {
+		var monthlyCosts float64
+		if month <= len(calc.Timeline) {
+			monthlyCosts = calc.Timeline[month-1].Total
+			if battery != nil {
+				battery.advance()
+			}
+		} else {
+			monthlyCosts = c.projectMonth(calc.Profile, calc, month, battery).Total
+		}
+		costs[month] = costs[month-1] + monthlyCosts
+	}
+	return costs
+}
+
 type BreakEvenAnalysis struct {
 	ElectricProfile     *models.CarProfile      `json:"electric_profile"`
 	CombustionProfile   *models.CarProfile      `json:"combustion_profile"`
@@ -132,6 +337,10 @@ type BreakEvenAnalysis struct {
 	BreakEvenMonths     int                     `json:"break_even_months"`
 	BreakEvenKilometers float64                 `json:"break_even_kilometers"`
 	TotalSavings        float64                 `json:"total_savings"`
+
+	// Cumulative costs per month, index 0 being the purchase
+	ElectricCumulativeCosts   []float64 `json:"electric_cumulative_costs"`
+	CombustionCumulativeCosts []float64 `json:"combustion_cumulative_costs"`
 }
 
 func (c *Calculator) ValidateProfile(profile *models.CarProfile) []string {
@@ -169,6 +378,10 @@ func (c *Calculator) ValidateProfile(profile *models.CarProfile) []string {
 		errors = append(errors, "Batteriegröße muss >= 0 sein")
 	}
 
+	if profile.EngineDisplacement < 0 || profile.CO2Emissions < 0 || profile.VehicleWeight < 0 {
+		errors = append(errors, "Hubraum, CO2-Ausstoß und Gesamtgewicht müssen >= 0 sein")
+	}
+
 	if profile.AnnualCarTax < 0 {
 		errors = append(errors, "Jährliche KFZ-Steuer muss >= 0 sein")
 	}
@@ -185,10 +398,138 @@ func (c *Calculator) ValidateProfile(profile *models.CarProfile) []string {
 		errors = append(errors, "Finanzierungslaufzeit muss >= 0 sein")
 	}
 
+	if profile.DownPayment < 0 {
+		errors = append(errors, "Anzahlung muss >= 0 sein")
+	}
+
+	if profile.DownPayment > profile.PurchasePrice {
+		errors = append(errors, "Anzahlung darf den Kaufpreis nicht übersteigen")
+	}
+
+	if profile.InterestRate < 0 {
+		errors = append(errors, "Effektivzins muss >= 0 sein")
+	}
+
+	if profile.BalloonPayment < 0 {
+		errors = append(errors, "Schlussrate muss >= 0 sein")
+	}
+
+	if profile.BalloonPayment > profile.PurchasePrice-profile.DownPayment {
+		errors = append(errors, "Schlussrate darf den Kreditbetrag nicht übersteigen")
+	}
+
+	if profile.AcquisitionType == models.AcquisitionLoan && profile.FinancingPeriod <= 0 && profile.FinancingRate <= 0 {
+		errors = append(errors, "Für einen Kredit ist eine Finanzierungslaufzeit erforderlich")
+	}
+
+	if profile.AcquisitionType == models.AcquisitionLease && profile.FinancingPeriod <= 0 {
+		errors = append(errors, "Für Leasing ist eine Leasinglaufzeit erforderlich")
+	}
+
+	if profile.LeaseSpecialPayment < 0 {
+		errors = append(errors, "Leasingsonderzahlung muss >= 0 sein")
+	}
+
+	if profile.LeaseAnnualKilometers < 0 {
+		errors = append(errors, "Vertragskilometer pro Jahr müssen >= 0 sein")
+	}
+
+	if profile.LeaseExcessKmCost < 0 || profile.LeaseUnusedKmCredit < 0 {
+		errors = append(errors, "Mehr- und Minderkilometersätze müssen >= 0 sein")
+	}
+
+	if profile.ServiceCost < 0 || profile.InspectionCost < 0 || profile.TireCost < 0 ||
+		profile.BrakeCost < 0 || profile.AnnualRepairReserve < 0 {
+		errors = append(errors, "Wartungs- und Verschleißkosten müssen >= 0 sein")
+	}
+
+	if profile.ServiceIntervalMonths < 0 || profile.ServiceIntervalKm < 0 || profile.InspectionIntervalMonths < 0 ||
+		profile.TireLifetimeKm < 0 || profile.BrakeIntervalKm < 0 {
+		errors = append(errors, "Wartungsintervalle müssen >= 0 sein")
+	}
+
+	for _, item := range profile.CustomCosts {
+		if item.Name == "" {
+			errors = append(errors, "Weitere Kosten benötigen eine Bezeichnung")
+		}
+		if item.Amount < 0 {
+			errors = append(errors, "Betrag für "+item.Name+" muss >= 0 sein")
+		}
+	}
+
+	if len(profile.ChargingSources) > 0 {
+		var totalShare float64
+		for _, source := range profile.ChargingSources {
+			if source.Price < 0 || source.Share < 0 {
+				errors = append(errors, "Preis und Anteil der Ladearten müssen >= 0 sein")
+			}
+			if source.Efficiency < 0 || source.Efficiency > 100 {
+				errors = append(errors, "Ladewirkungsgrad muss zwischen 0 und 100% liegen")
+			}
+			totalShare += source.Share
+		}
+		if math.Abs(totalShare-100) > 0.01 {
+			errors = append(errors, "Die Anteile der Ladearten müssen zusammen 100% ergeben")
+		}
+	}
+
+	if profile.ChargingEfficiency < 0 || profile.ChargingEfficiency > 100 {
+		errors = append(errors, "Ladewirkungsgrad muss zwischen 0 und 100% liegen")
+	}
+
+	if profile.ElectricShare < 0 || profile.ElectricShare > 100 {
+		errors = append(errors, "Elektrischer Fahranteil muss zwischen 0 und 100% liegen")
+	}
+
+	if profile.DailyCommuteKm < 0 {
+		errors = append(errors, "Tägliche Pendelstrecke muss >= 0 sein")
+	}
+
+	if profile.DrivingProfile {
+		errors = append(errors, validateDrivingProfile(profile)...)
+	}
+
+	errors = append(errors, validateSeasonalFactors(profile)...)
+	errors = append(errors, validateBattery(profile)...)
+	errors = append(errors, validateUsedCar(profile)...)
+	errors = append(errors, validateInsurance(profile)...)
+
 	if profile.PurchasePrice < 0 {
 		errors = append(errors, "Kaufpreis muss >= 0 sein")
 	}
 
+	if profile.DepreciationRate < 0 || profile.DepreciationRate > 100 {
+		errors = append(errors, "Wertverlust pro Jahr muss zwischen 0 und 100% liegen")
+	}
+
+	if profile.ExpectedResaleValue < 0 {
+		errors = append(errors, "Erwarteter Wiederverkaufswert muss >= 0 sein")
+	}
+
+	if profile.DepreciationModel == models.DepreciationResaleValue && profile.ExpectedResaleValue <= 0 {
+		errors = append(errors, "Für das Wertverlustmodell Wiederverkaufswert ist ein erwarteter Wiederverkaufswert erforderlich")
+	}
+
+	if profile.AnnualTHGRevenue < 0 || profile.PurchaseIncentives < 0 {
+		errors = append(errors, "THG-Quote und Kaufprämien müssen >= 0 sein")
+	}
+
+	if profile.CompanyCar && profile.PurchasePrice <= 0 {
+		errors = append(errors, "Für einen Dienstwagen ist der Bruttolistenpreis als Kaufpreis erforderlich")
+	}
+
+	if profile.CommuteDistance < 0 {
+		errors = append(errors, "Entfernung zur Arbeitsstätte muss >= 0 sein")
+	}
+
+	if profile.PrivateUseShare < 0 || profile.PrivateUseShare > 100 {
+		errors = append(errors, "Privatanteil muss zwischen 0 und 100% liegen")
+	}
+
+	if profile.PersonalTaxRate < 0 || profile.PersonalTaxRate > 100 {
+		errors = append(errors, "Persönlicher Steuersatz muss zwischen 0 und 100% liegen")
+	}
+
 	if profile.ExpectedYearsOfOwnership <= 0 {
 		errors = append(errors, "Erwartete Besitzdauer muss > 0 sein")
 	}
@@ -0,0 +1,135 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+
+	"auto-unterhaltsrechner/internal/models"
+)
+
+func TestCalculateBreakEvenOwnershipLength(t *testing.T) {
+	tests := []struct {
+		name            string
+		electricYears   int
+		combustionYears int
+		wantPoints      int
+	}{
+		{"short ownership", 5, 5, 5*12 + 1},
+		{"electric beyond 30 years", 31, 5, 31*12 + 1},
+		{"combustion beyond 30 years", 5, 40, 40*12 + 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			electric := &models.CarProfile{
+				ElectricConsumption:      18,
+				ElectricityPrice:         0.35,
+				MonthlyKilometers:        1000,
+				PurchasePrice:            40000,
+				ExpectedYearsOfOwnership: tt.electricYears,
+			}
+			combustion := &models.CarProfile{
+				FuelType:                 models.Super,
+				FuelConsumption:          7,
+				FuelPrice:                1.8,
+				MonthlyKilometers:        1000,
+				PurchasePrice:            30000,
+				ExpectedYearsOfOwnership: tt.combustionYears,
+			}
+
+			analysis := New().CalculateBreakEven(electric, combustion)
+			if analysis == nil {
+				t.Fatal("CalculateBreakEven() = nil")
+			}
+			if analysis.BreakEvenMonths < 0 {
+				t.Fatalf("BreakEvenMonths = %d, want a break-even point", analysis.BreakEvenMonths)
+			}
+			if got := len(analysis.ElectricCumulativeCosts); got < tt.wantPoints {
+				t.Errorf("len(ElectricCumulativeCosts) = %d, want at least %d", got, tt.wantPoints)
+			}
+			if got, want := len(analysis.CombustionCumulativeCosts), len(analysis.ElectricCumulativeCosts); got != want {
+				t.Errorf("len(CombustionCumulativeCosts) = %d, want %d", got, want)
+			}
+			total := tt.electricYears * 12
+			if total < len(analysis.ElectricCumulativeCosts) {
+				want := analysis.CombustionCumulativeCosts[total] - analysis.ElectricCumulativeCosts[total]
+				if analysis.TotalSavings != want {
+					t.Errorf("TotalSavings = %.2f, want %.2f", analysis.TotalSavings, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCalculateCostsMonthlyMatchesAnnual(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile *models.CarProfile
+	}{
+		{"new combustion car", &models.CarProfile{
+			FuelType:                 models.Super,
+			FuelConsumption:          7,
+			FuelPrice:                1.8,
+			MonthlyKilometers:        1500,
+			AnnualCarInsurance:       600,
+			ServiceCost:              300,
+			AnnualRepairReserve:      400,
+			ExpectedYearsOfOwnership: 5,
+		}},
+		{"six-year-old combustion car", &models.CarProfile{
+			FuelType:                 models.Super,
+			FuelConsumption:          7,
+			FuelPrice:                1.8,
+			MonthlyKilometers:        1500,
+			AnnualCarInsurance:       600,
+			ServiceCost:              300,
+			AnnualRepairReserve:      400,
+			FirstRegistration:        ownershipStart().AddDate(-6, 0, 0),
+			ExpectedYearsOfOwnership: 5,
+		}},
+		{"loan ending after six months", &models.CarProfile{
+			AcquisitionType:          models.AcquisitionLoan,
+			FuelType:                 models.Super,
+			FuelConsumption:          7,
+			FuelPrice:                1.8,
+			MonthlyKilometers:        1500,
+			PurchasePrice:            20000,
+			DownPayment:              5000,
+			InterestRate:             5,
+			FinancingPeriod:          6,
+			ExpectedYearsOfOwnership: 5,
+		}},
+		{"lease settled after a year", &models.CarProfile{
+			AcquisitionType:          models.AcquisitionLease,
+			FuelType:                 models.Super,
+			FuelConsumption:          7,
+			FuelPrice:                1.8,
+			MonthlyKilometers:        1500,
+			FinancingRate:            300,
+			FinancingPeriod:          12,
+			LeaseAnnualKilometers:    15000,
+			LeaseExcessKmCost:        0.1,
+			ExpectedYearsOfOwnership: 1,
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := New().CalculateCosts(tt.profile)
+			if got, want := calc.MonthlyRunningCosts*12, calc.AnnualRunningCosts; math.Abs(got-want) > 0.01 {
+				t.Errorf("MonthlyRunningCosts × 12 = %.2f, want AnnualRunningCosts %.2f", got, want)
+			}
+			if got, want := calc.MonthlyFinancingCost*12, calc.AnnualFinancingCost; math.Abs(got-want) > 0.01 {
+				t.Errorf("MonthlyFinancingCost × 12 = %.2f, want AnnualFinancingCost %.2f", got, want)
+			}
+
+			// The line items shown per month add up to the monthly total
+			items := (calc.AnnualFuelCost+calc.AnnualElectricityCost+calc.AnnualTaxCost+calc.AnnualInsuranceCost)/12 +
+				calc.MonthlyServiceCost + calc.MonthlyInspectionCost + calc.MonthlyTireCost + calc.MonthlyBrakeCost +
+				calc.MonthlyRepairReserve + calc.MonthlyCustomCost + calc.MonthlyFinancingCost - calc.MonthlyRevenue
+			if math.Abs(items-calc.MonthlyRunningCosts) > 0.01 {
+				t.Errorf("sum of monthly line items = %.2f, want MonthlyRunningCosts %.2f", items, calc.MonthlyRunningCosts)
+			}
+		})
+	}
+}
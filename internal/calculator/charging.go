@@ -0,0 +1,110 @@
+package calculator
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+)
+
+// defaultChargingEfficiencies is the share (%) of the energy drawn from the
+// grid that reaches the battery, per charging method. A household socket
+// charges slowly with high relative losses, DC chargers are metered behind
+// the converter.
+var defaultChargingEfficiencies = map[models.ElectricityType]float64{
+	models.HomeSocket:            80,
+	models.HomeWallbox:           88,
+	models.WorkplaceCharging:     88,
+	models.PublicChargingStation: 88,
+	models.PublicFastCharging:    95,
+}
+
+// defaultChargingEfficiency is used for charging types without a default
+const defaultChargingEfficiency = 85.0
+
+// DefaultChargingEfficiency returns the default charging efficiency (%) of
+// a charging method.
+func DefaultChargingEfficiency(electricityType models.ElectricityType) float64 {
+	if efficiency, ok := defaultChargingEfficiencies[electricityType]; ok {
+		return efficiency
+	}
+	return defaultChargingEfficiency
+}
+
+// chargingSources returns the charging sources of the profile. Profiles
+// without a charging mix charge everything at ElectricityPrice.
+func chargingSources(profile *models.CarProfile) []models.ChargingSource {
+	if len(profile.ChargingSources) > 0 {
+		return profile.ChargingSources
+	}
+	return []models.ChargingSource{{
+		Type:       profile.ElectricityType,
+		Price:      profile.ElectricityPrice,
+		Share:      100,
+		Efficiency: profile.ChargingEfficiency,
+	}}
+}
+
+// averageElectricityPrice returns the share-weighted price of the charging
+// sources of the profile.
+func averageElectricityPrice(profile *models.CarProfile) float64 {
+	var price, totalShare float64
+	for _, source := range chargingSources(profile) {
+		price += source.Price * source.Share
+		totalShare += source.Share
+	}
+	if totalShare <= 0 {
+		return profile.ElectricityPrice
+	}
+	return price / totalShare
+}
+
+// calculateChargingCosts splits the monthly energy reaching the battery
+// between the charging sources by their share and adds the charging losses
+// of each source. It returns the energy drawn from the grid, the blended
+// price per kWh drawn and the breakdown per source. Shares not adding up to
+// 100% are scaled proportionally.
+func (c *Calculator) calculateChargingCosts(profile *models.CarProfile, energy float64) (float64, float64, []models.ChargingCost) {
+	sources := chargingSources(profile)
+
+	var totalShare float64
+	for _, source := range sources {
+		totalShare += source.Share
+	}
+	if totalShare <= 0 {
+		return 0, 0, nil
+	}
+
+	var gridEnergy, totalCost float64
+	breakdown := make([]models.ChargingCost, 0, len(sources))
+	for _, source := range sources {
+		share := source.Share / totalShare
+		efficiency := source.Efficiency
+		if efficiency <= 0 {
+			efficiency = DefaultChargingEfficiency(source.Type)
+		}
+
+		cost := models.ChargingCost{
+			Type:       source.Type,
+			Share:      share * 100,
+			Price:      source.Price,
+			Energy:     energy * share,
+			Efficiency: efficiency,
+		}
+		cost.GridEnergy = cost.Energy / (efficiency / 100)
+		cost.Monthly = cost.GridEnergy * cost.Price
+
+		gridEnergy += cost.GridEnergy
+		totalCost += cost.Monthly
+		breakdown = append(breakdown, cost)
+	}
+
+	// Without any energy the blended price is the weighted list price
+	blendedPrice := 0.0
+	if gridEnergy > 0 {
+		blendedPrice = totalCost / gridEnergy
+	} else {
+		for _, source := range sources {
+			blendedPrice += source.Share / totalShare * source.Price
+		}
+	}
+
+	return gridEnergy, blendedPrice, breakdown
+}
@@ -0,0 +1,126 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+
+	"auto-unterhaltsrechner/internal/models"
+)
+
+func TestCalculateChargingCosts(t *testing.T) {
+	// 200 kWh per month reaching the battery, without charging losses
+	home := models.ChargingSource{Type: models.HomeWallbox, Price: 0.3, Share: 60, Efficiency: 100}
+	public := models.ChargingSource{Type: models.PublicChargingStation, Price: 0.6, Share: 40, Efficiency: 100}
+
+	tests := []struct {
+		name             string
+		profile          models.CarProfile
+		energy           float64
+		wantGridEnergy   float64
+		wantBlendedPrice float64
+		wantSources      int
+	}{
+		{"without charging mix", models.CarProfile{ElectricityType: models.HomeWallbox,
+			ElectricityPrice: 0.3, ChargingEfficiency: 100}, 200, 200, 0.3, 1},
+		// 120 kWh × 0,30 € + 80 kWh × 0,60 € = 84 € for 200 kWh
+		{"home and public", models.CarProfile{ChargingSources: []models.ChargingSource{home, public}}, 200, 200, 0.42, 2},
+		{"shares scaled to 100%", models.CarProfile{ChargingSources: []models.ChargingSource{
+			{Type: models.HomeWallbox, Price: 0.3, Share: 30, Efficiency: 100},
+			{Type: models.PublicChargingStation, Price: 0.6, Share: 20, Efficiency: 100},
+		}}, 200, 200, 0.42, 2},
+		// The weighted list price without any energy
+		{"not driven electrically", models.CarProfile{ChargingSources: []models.ChargingSource{home, public}}, 0, 0, 0.42, 2},
+		{"without shares", models.CarProfile{ChargingSources: []models.ChargingSource{
+			{Type: models.HomeWallbox, Price: 0.3},
+		}}, 200, 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gridEnergy, blendedPrice, breakdown := New().calculateChargingCosts(&tt.profile, tt.energy)
+			if math.Abs(gridEnergy-tt.wantGridEnergy) > 0.005 {
+				t.Errorf("grid energy = %.2f, want %.2f", gridEnergy, tt.wantGridEnergy)
+			}
+			if math.Abs(blendedPrice-tt.wantBlendedPrice) > 1e-9 {
+				t.Errorf("blended price = %.4f, want %.4f", blendedPrice, tt.wantBlendedPrice)
+			}
+			if len(breakdown) != tt.wantSources {
+				t.Fatalf("breakdown has %d sources, want %d", len(breakdown), tt.wantSources)
+			}
+
+			var share, monthly float64
+			for _, cost := range breakdown {
+				share += cost.Share
+				monthly += cost.Monthly
+			}
+			if tt.wantSources > 0 && math.Abs(share-100) > 1e-9 {
+				t.Errorf("shares add up to %.2f%%, want 100%%", share)
+			}
+			if math.Abs(monthly-gridEnergy*blendedPrice) > 1e-9 {
+				t.Errorf("monthly cost = %.2f, want grid energy × blended price %.2f", monthly, gridEnergy*blendedPrice)
+			}
+		})
+	}
+}
+
+func TestChargingEfficiency(t *testing.T) {
+	// 200 kWh per month reaching the battery at 0,30 €/kWh drawn
+	tests := []struct {
+		name           string
+		sources        []models.ChargingSource
+		wantGridEnergy float64
+		wantMonthly    float64
+	}{
+		// 200 ÷ 80%
+		{"household socket", []models.ChargingSource{{Type: models.HomeSocket, Price: 0.3, Share: 100}}, 250, 75},
+		// 200 ÷ 88%
+		{"wallbox", []models.ChargingSource{{Type: models.HomeWallbox, Price: 0.3, Share: 100}}, 227.27, 68.18},
+		// 200 ÷ 95%
+		{"fast charging", []models.ChargingSource{{Type: models.PublicFastCharging, Price: 0.3, Share: 100}}, 210.53, 63.16},
+		{"entered efficiency", []models.ChargingSource{{Type: models.HomeSocket, Price: 0.3, Share: 100, Efficiency: 90}},
+			222.22, 66.67},
+		// 200 ÷ 85%
+		{"unknown charging type", []models.ChargingSource{{Price: 0.3, Share: 100}}, 235.29, 70.59},
+		// 100 ÷ 80% + 100 ÷ 95%, each at its own losses
+		{"socket and fast charging", []models.ChargingSource{
+			{Type: models.HomeSocket, Price: 0.3, Share: 50},
+			{Type: models.PublicFastCharging, Price: 0.3, Share: 50},
+		}, 230.26, 69.08},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := &models.CarProfile{ChargingSources: tt.sources}
+			gridEnergy, blendedPrice, _ := New().calculateChargingCosts(profile, 200)
+			if math.Abs(gridEnergy-tt.wantGridEnergy) > 0.005 {
+				t.Errorf("grid energy = %.2f, want %.2f", gridEnergy, tt.wantGridEnergy)
+			}
+			if got := gridEnergy * blendedPrice; math.Abs(got-tt.wantMonthly) > 0.005 {
+				t.Errorf("monthly cost = %.2f, want %.2f", got, tt.wantMonthly)
+			}
+		})
+	}
+}
+
+func TestCalculateCostsChargingLosses(t *testing.T) {
+	// 20 kWh/100 km over 1000 km: 200 kWh reach the battery, 250 kWh are
+	// drawn through the household socket
+	profile := &models.CarProfile{
+		ElectricConsumption:      20,
+		ElectricityType:          models.HomeSocket,
+		ElectricityPrice:         0.3,
+		MonthlyKilometers:        1000,
+		ExpectedYearsOfOwnership: 1,
+	}
+	calc := New().CalculateCosts(profile)
+
+	if math.Abs(calc.MonthlyElectricityAmount-200) > 1e-9 {
+		t.Errorf("MonthlyElectricityAmount = %.2f, want 200", calc.MonthlyElectricityAmount)
+	}
+	if math.Abs(calc.MonthlyGridEnergy-250) > 1e-9 {
+		t.Errorf("MonthlyGridEnergy = %.2f, want 250", calc.MonthlyGridEnergy)
+	}
+	if math.Abs(calc.MonthlyElectricityCost-75) > 1e-9 {
+		t.Errorf("MonthlyElectricityCost = %.2f, want 75", calc.MonthlyElectricityCost)
+	}
+}
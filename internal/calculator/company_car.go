@@ -0,0 +1,102 @@
+package calculator
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+	"math"
+)
+
+// Taxable benefit of a company car used privately (§ 6 Abs. 1 Nr. 4 and
+// § 8 Abs. 2 EStG)
+const (
+	// Flat rate per month in % of the gross list price
+	companyCarFlatRate = 1.0
+
+	// Surcharge per month and km one-way for commuting between home and work
+	companyCarCommuteRate = 0.03
+
+	// Electric cars up to this gross list price are taxed on a quarter of
+	// the list price, more expensive ones on half of it
+	quarterRateListPriceLimit = 100000
+
+	// Plug-in hybrids are taxed on half of the list price if they emit at
+	// most 50 g/km CO2 or reach 80 km electrically
+	halfRateMaxCO2           = 50
+	halfRateMinElectricRange = 80
+
+	// Depreciation period of a car for the Fahrtenbuch method
+	companyCarDepreciationMonths = 6 * 12
+)
+
+// companyCarListPriceFactor returns the share of the gross list price the
+// benefit is based on, reduced for electric cars and plug-in hybrids.
+func companyCarListPriceFactor(profile *models.CarProfile) float64 {
+	switch profile.CompanyCarMethod {
+	case models.CompanyCarOnePercent:
+		return 1
+	case models.CompanyCarHalfPercent:
+		return 0.5
+	case models.CompanyCarQuarterPercent:
+		return 0.25
+	}
+
+	switch {
+	case isBatteryElectric(profile) && profile.PurchasePrice <= quarterRateListPriceLimit:
+		return 0.25
+	case isBatteryElectric(profile):
+		return 0.5
+	case isPlugInHybrid(profile) && qualifiesForHalfRate(profile):
+		return 0.5
+	}
+	return 1
+}
+
+func qualifiesForHalfRate(profile *models.CarProfile) bool {
+	if profile.CO2Emissions > 0 && profile.CO2Emissions <= halfRateMaxCO2 {
+		return true
+	}
+	return profile.BatterySize/profile.ElectricConsumption*100 >= halfRateMinElectricRange
+}
+
+// calculateCompanyCarBenefit calculates the monthly taxable benefit of a
+// company car with the flat rate and the Fahrtenbuch method and the
+// resulting reduction of the net salary for the selected method, which is
+// what the car costs the employee over the ownership period.
+func (c *Calculator) calculateCompanyCarBenefit(profile *models.CarProfile, calc *models.CostCalculation) {
+	if !profile.CompanyCar {
+		return
+	}
+
+	// The list price is rounded down to full hundred euros
+	factor := companyCarListPriceFactor(profile)
+	listPrice := math.Floor(profile.PurchasePrice/100) * 100 * factor
+
+	calc.CompanyCarRate = companyCarFlatRate * factor
+	calc.CompanyCarListPriceBenefit = listPrice * companyCarFlatRate / 100
+	calc.CompanyCarCommuteBenefit = listPrice * companyCarCommuteRate / 100 * profile.CommuteDistance
+	calc.CompanyCarFlatRateBenefit = calc.CompanyCarListPriceBenefit + calc.CompanyCarCommuteBenefit
+
+	// The Fahrtenbuch method taxes the private share of the total car costs
+	// of the first year: the running costs before revenues like the
+	// THG-Quote, which the employer receives, plus the lease payments or the
+	// depreciation (AfA), reduced like the list price
+	totalCosts := (calc.AnnualRunningCosts - calc.AnnualFinancingCost + calc.AnnualRevenue) / 12
+	if usesLease(profile) {
+		leaseCosts := profile.FinancingRate
+		if months := leaseMonths(profile); months > 0 {
+			leaseCosts += profile.LeaseSpecialPayment / float64(months)
+		}
+		totalCosts += leaseCosts * factor
+	} else {
+		totalCosts += profile.PurchasePrice * factor / companyCarDepreciationMonths
+	}
+	calc.CompanyCarLogbookBenefit = totalCosts * profile.PrivateUseShare / 100
+
+	calc.CompanyCarBenefit = calc.CompanyCarFlatRateBenefit
+	if profile.CompanyCarMethod == models.CompanyCarLogbook {
+		calc.CompanyCarBenefit = calc.CompanyCarLogbookBenefit
+	}
+
+	calc.MonthlyCompanyCarCost = calc.CompanyCarBenefit * profile.PersonalTaxRate / 100
+	calc.AnnualCompanyCarCost = calc.MonthlyCompanyCarCost * 12
+	calc.CompanyCarTotalCost = calc.MonthlyCompanyCarCost * float64(len(calc.Timeline))
+}
@@ -0,0 +1,149 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+
+	"auto-unterhaltsrechner/internal/models"
+)
+
+func TestCalculateCompanyCarBenefit(t *testing.T) {
+	combustion := models.CarProfile{FuelConsumption: 6}
+	electric := models.CarProfile{ElectricConsumption: 18}
+	plugInHybrid := models.CarProfile{FuelConsumption: 5, ElectricConsumption: 20, BatterySize: 10}
+
+	tests := []struct {
+		name         string
+		base         models.CarProfile
+		method       models.CompanyCarMethod
+		listPrice    float64
+		co2          float64
+		runningCosts float64 // €/month without financing and revenues
+		wantBenefit  float64
+	}{
+		// 45600 × 1% + 45600 × 0,03% × 20 km
+		{"combustion 1%", combustion, models.CompanyCarFlatRate, 45678, 0, 0, 729.60},
+		// 15000 × 1% + 15000 × 0,03% × 20 km
+		{"electric 0.25%", electric, models.CompanyCarFlatRate, 60000, 0, 0, 240},
+		{"electric at price limit", electric, models.CompanyCarFlatRate, 100000, 0, 0, 400},
+		// 60000 × 1% + 60000 × 0,03% × 20 km
+		{"expensive electric 0.5%", electric, models.CompanyCarFlatRate, 120000, 0, 0, 960},
+		// 20000 × 1% + 20000 × 0,03% × 20 km
+		{"plug-in hybrid up to 50 g/km", plugInHybrid, models.CompanyCarFlatRate, 40000, 40, 0, 320},
+		// 50 km electric range, 60 g/km
+		{"plug-in hybrid without half rate", plugInHybrid, models.CompanyCarFlatRate, 40000, 60, 0, 640},
+		{"forced 1% for electric", electric, models.CompanyCarOnePercent, 60000, 0, 0, 960},
+		{"forced 0.5%", combustion, models.CompanyCarHalfPercent, 40000, 0, 0, 320},
+		// (400 + 36000 ÷ 72) × 30%
+		{"logbook combustion", combustion, models.CompanyCarLogbook, 36000, 0, 400, 270},
+		// (300 + 60000 × 25% ÷ 72) × 30%
+		{"logbook electric", electric, models.CompanyCarLogbook, 60000, 0, 300, 152.50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := tt.base
+			profile.CompanyCar = true
+			profile.CompanyCarMethod = tt.method
+			profile.PurchasePrice = tt.listPrice
+			profile.CO2Emissions = tt.co2
+			profile.CommuteDistance = 20
+			profile.PrivateUseShare = 30
+			profile.PersonalTaxRate = 40
+
+			calc := &models.CostCalculation{AnnualRunningCosts: tt.runningCosts * 12}
+			New().calculateCompanyCarBenefit(&profile, calc)
+			if math.Abs(calc.CompanyCarBenefit-tt.wantBenefit) > 0.005 {
+				t.Errorf("CompanyCarBenefit = %.2f, want %.2f", calc.CompanyCarBenefit, tt.wantBenefit)
+			}
+			if want := tt.wantBenefit * 0.4; math.Abs(calc.MonthlyCompanyCarCost-want) > 0.005 {
+				t.Errorf("MonthlyCompanyCarCost = %.2f, want %.2f", calc.MonthlyCompanyCarCost, want)
+			}
+		})
+	}
+}
+
+func TestCompanyCarLogbookCosts(t *testing.T) {
+	// 400 € running costs per month in the first year, 30% private use
+	base := models.CarProfile{
+		FuelConsumption:          6,
+		PurchasePrice:            36000,
+		CompanyCar:               true,
+		CompanyCarMethod:         models.CompanyCarLogbook,
+		PrivateUseShare:          30,
+		PersonalTaxRate:          40,
+		ExpectedYearsOfOwnership: 3,
+	}
+
+	tests := []struct {
+		name        string
+		modify      func(*models.CarProfile)
+		calc        models.CostCalculation
+		wantBenefit float64
+	}{
+		// (400 + 36000 ÷ 72) × 30%
+		{"cash purchase", nil, models.CostCalculation{AnnualRunningCosts: 4800}, 270},
+		// The THG-Quote of 50 € per month goes to the employer:
+		// (350 + 50 + 500) × 30%
+		{"THG revenue", nil, models.CostCalculation{AnnualRunningCosts: 4200, AnnualRevenue: 600}, 270},
+		// Loan payments of 600 € are replaced by the depreciation
+		{"loan", func(p *models.CarProfile) {
+			p.AcquisitionType = models.AcquisitionLoan
+		}, models.CostCalculation{AnnualRunningCosts: 12000, AnnualFinancingCost: 7200}, 270},
+		// (400 + 450 rate + 3600 ÷ 36) × 30%
+		{"lease", func(p *models.CarProfile) {
+			p.AcquisitionType = models.AcquisitionLease
+			p.FinancingRate = 450
+			p.FinancingPeriod = 36
+			p.LeaseSpecialPayment = 3600
+		}, models.CostCalculation{AnnualRunningCosts: 10200, AnnualFinancingCost: 5400}, 285},
+		// (300 + 25% × (400 rate + 0)) × 30%
+		{"leased electric car", func(p *models.CarProfile) {
+			p.FuelConsumption = 0
+			p.ElectricConsumption = 18
+			p.AcquisitionType = models.AcquisitionLease
+			p.FinancingRate = 400
+			p.FinancingPeriod = 36
+		}, models.CostCalculation{AnnualRunningCosts: 8400, AnnualFinancingCost: 4800}, 120},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := base
+			if tt.modify != nil {
+				tt.modify(&profile)
+			}
+			calc := tt.calc
+			New().calculateCompanyCarBenefit(&profile, &calc)
+			if math.Abs(calc.CompanyCarLogbookBenefit-tt.wantBenefit) > 0.005 {
+				t.Errorf("CompanyCarLogbookBenefit = %.2f, want %.2f", calc.CompanyCarLogbookBenefit, tt.wantBenefit)
+			}
+		})
+	}
+}
+
+func TestCompanyCarTotalCost(t *testing.T) {
+	// 40000 × 1% × 40% per month over 3 years
+	profile := &models.CarProfile{
+		AcquisitionType:          models.AcquisitionCash,
+		FuelConsumption:          6,
+		FuelPrice:                1.8,
+		MonthlyKilometers:        1000,
+		PurchasePrice:            40000,
+		CompanyCar:               true,
+		PersonalTaxRate:          40,
+		ExpectedYearsOfOwnership: 3,
+	}
+	calc := New().CalculateCosts(profile)
+	if math.Abs(calc.CompanyCarTotalCost-5760) > 0.005 {
+		t.Errorf("CompanyCarTotalCost = %.2f, want 5760", calc.CompanyCarTotalCost)
+	}
+}
+
+func TestCalculateCompanyCarBenefitPrivateCar(t *testing.T) {
+	calc := &models.CostCalculation{}
+	New().calculateCompanyCarBenefit(&models.CarProfile{PurchasePrice: 40000}, calc)
+	if calc.CompanyCarBenefit != 0 || calc.MonthlyCompanyCarCost != 0 {
+		t.Errorf("benefit of a private car = %.2f, want 0", calc.CompanyCarBenefit)
+	}
+}
@@ -0,0 +1,29 @@
+package calculator
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+)
+
+// calculateCustomCosts converts the custom cost items of the profile into
+// monthly amounts. One-off items are paid at purchase.
+func (c *Calculator) calculateCustomCosts(profile *models.CarProfile, calc *models.CostCalculation) {
+	calc.CustomCostBreakdown = make([]models.CustomCost, 0, len(profile.CustomCosts))
+
+	for _, item := range profile.CustomCosts {
+		cost := models.CustomCost{Name: item.Name, Recurrence: item.Recurrence}
+		switch item.Recurrence {
+		case models.RecurrenceOnce:
+			cost.OneOff = item.Amount
+		case models.RecurrenceMonthly:
+			cost.Monthly = item.Amount
+		case models.RecurrenceAnnual:
+			cost.Monthly = item.Amount / 12
+		case models.RecurrencePerKm:
+			cost.Monthly = item.Amount * profile.MonthlyKilometers
+		}
+
+		calc.MonthlyCustomCost += cost.Monthly
+		calc.OneOffCustomCost += cost.OneOff
+		calc.CustomCostBreakdown = append(calc.CustomCostBreakdown, cost)
+	}
+}
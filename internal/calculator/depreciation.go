@@ -0,0 +1,136 @@
+package calculator
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+	"math"
+)
+
+const (
+	// defaultDecliningBalanceRate is used when no depreciation rate is entered
+	defaultDecliningBalanceRate = 15.0
+
+	// referenceAnnualKilometers is the mileage the age curve is based on
+	referenceAnnualKilometers = 15000.0
+
+	// minimumResidualShare is the share of the purchase price a car keeps
+	// at least, e.g. as scrap or export value
+	minimumResidualShare = 0.05
+)
+
+// DepreciationModel estimates the value of a car over its ownership period.
+type DepreciationModel interface {
+	// ResidualValue returns the value of the car after the given number of months.
+	ResidualValue(profile *models.CarProfile, months int) float64
+}
+
+// LinearDepreciation loses value in equal steps down to 20% of the purchase
+// price at the end of the ownership period, or 10% for cars older than 10
+// years at sale.
+type LinearDepreciation struct{}
+
+func (LinearDepreciation) ResidualValue(profile *models.CarProfile, months int) float64 {
+	residualValuePercentage := 0.20
+
+	// For cars older than 10 years, depreciation slows down
+	if vehicleAgeYears(profile)+float64(profile.ExpectedYearsOfOwnership) > 10 {
+		residualValuePercentage = 0.10
+	}
+
+	return interpolateValue(profile, profile.PurchasePrice*residualValuePercentage, months)
+}
+
+// DecliningBalanceDepreciation loses a fixed percentage of the remaining
+// value every year.
+type DecliningBalanceDepreciation struct {
+	Rate float64 // % p.a.
+}
+
+func (d DecliningBalanceDepreciation) ResidualValue(profile *models.CarProfile, months int) float64 {
+	return profile.PurchasePrice * math.Pow(1-d.Rate/100, float64(months)/12)
+}
+
+// AgeMileageDepreciation follows a typical market curve: a large loss in the
+// first year, about 10% per year afterwards, adjusted by the odometer reading
+// compared to 15.000 km per year of age. Electric cars lose more in the first
+// year. A used car continues on the curve from its age and odometer reading
+// at purchase.
+type AgeMileageDepreciation struct{}
+
+func (AgeMileageDepreciation) ResidualValue(profile *models.CarProfile, months int) float64 {
+	age := vehicleAgeYears(profile)
+	atPurchase := marketValueShare(profile, age, profile.OdometerAtPurchase)
+	atMonth := marketValueShare(profile, age+float64(months)/12, odometerAt(profile, months))
+
+	return math.Max(profile.PurchasePrice*atMonth/atPurchase, profile.PurchasePrice*minimumResidualShare)
+}
+
+// marketValueShare returns the value of a car of the given age in years and
+// odometer reading as share of its price when new.
+func marketValueShare(profile *models.CarProfile, age, odometer float64) float64 {
+	firstYearLoss := 0.24
+	if isBatteryElectric(profile) {
+		firstYearLoss = 0.30
+	}
+	ageFactor := math.Pow(1-firstYearLoss, math.Min(age, 1)) * math.Pow(0.90, math.Max(age-1, 0))
+
+	// Every 5.000 km above (below) the reference mileage cost (add) 1%
+	excessKm := odometer - referenceAnnualKilometers*age
+	mileageFactor := math.Min(math.Max(1-excessKm/5000*0.01, 0.5), 1.15)
+
+	return ageFactor * mileageFactor
+}
+
+// ResaleValueDepreciation loses value in equal steps down to the resale
+// value the user expects at the end of the ownership period.
+type ResaleValueDepreciation struct {
+	ResaleValue float64
+}
+
+func (d ResaleValueDepreciation) ResidualValue(profile *models.CarProfile, months int) float64 {
+	return interpolateValue(profile, d.ResaleValue, months)
+}
+
+// interpolateValue returns the value after the given number of months on a
+// straight line from the purchase price to the value at the end of ownership.
+func interpolateValue(profile *models.CarProfile, endValue float64, months int) float64 {
+	ownershipMonths := profile.ExpectedYearsOfOwnership * 12
+	if ownershipMonths <= 0 {
+		return profile.PurchasePrice
+	}
+
+	share := float64(months) / float64(ownershipMonths)
+	return profile.PurchasePrice - (profile.PurchasePrice-endValue)*share
+}
+
+// depreciationModelFor returns the depreciation model selected in the profile.
+func depreciationModelFor(profile *models.CarProfile) DepreciationModel {
+	switch profile.DepreciationModel {
+	case models.DepreciationDecliningBalance:
+		rate := profile.DepreciationRate
+		if rate <= 0 {
+			rate = defaultDecliningBalanceRate
+		}
+		return DecliningBalanceDepreciation{Rate: rate}
+	case models.DepreciationAgeMileage:
+		return AgeMileageDepreciation{}
+	case models.DepreciationResaleValue:
+		return ResaleValueDepreciation{ResaleValue: profile.ExpectedResaleValue}
+	default:
+		return LinearDepreciation{}
+	}
+}
+
+// calculateResidualValues returns the value of the car at the end of each
+// year of ownership.
+func (c *Calculator) calculateResidualValues(profile *models.CarProfile) []float64 {
+	if profile.PurchasePrice <= 0 || profile.ExpectedYearsOfOwnership <= 0 {
+		return nil
+	}
+
+	model := depreciationModelFor(profile)
+	values := make([]float64, 0, profile.ExpectedYearsOfOwnership)
+	for year := 1; year <= profile.ExpectedYearsOfOwnership; year++ {
+		values = append(values, model.ResidualValue(profile, year*12))
+	}
+	return values
+}
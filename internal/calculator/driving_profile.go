@@ -0,0 +1,166 @@
+package calculator
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+)
+
+// tripsPerMonth converts the frequency of a trip to trips per month
+var tripsPerMonth = map[models.TripFrequency]float64{
+	models.TripWorkday: workdaysPerMonth,
+	models.TripWeekly:  52.0 / 12,
+	models.TripMonthly: 1,
+	models.TripAnnual:  1.0 / 12,
+}
+
+// applyDrivingProfile returns the profile with the monthly kilometers and
+// the consumptions derived from its driving profile, and the share of the
+// distance per road type. Recurring trips, if entered, replace both the
+// driving mix and the monthly kilometers. Road types without a consumption
+// use the overall consumption of the profile. Profiles without a driving
+// profile are returned unchanged.
+func (c *Calculator) applyDrivingProfile(profile *models.CarProfile) (*models.CarProfile, models.RoadTypeValues) {
+	if !profile.DrivingProfile {
+		return profile, models.RoadTypeValues{}
+	}
+
+	mix := profile.DrivingMix
+	derived := profile.Clone()
+	if km, tripMix := calculateTripKilometers(profile.Trips); km > 0 {
+		derived.MonthlyKilometers = km
+		mix = tripMix
+	}
+
+	// Shares not adding up to 100% are scaled proportionally
+	total := mix.Total()
+	if total <= 0 {
+		return profile, models.RoadTypeValues{}
+	}
+	mix = models.RoadTypeValues{
+		City:     mix.City / total * 100,
+		Rural:    mix.Rural / total * 100,
+		Motorway: mix.Motorway / total * 100,
+	}
+
+	derived.FuelConsumption = weightedConsumption(profile.FuelConsumptionByRoad, profile.FuelConsumption, mix)
+	derived.ElectricConsumption = weightedConsumption(profile.ElectricConsumptionByRoad, profile.ElectricConsumption, mix)
+	return derived, mix
+}
+
+func validateDrivingProfile(profile *models.CarProfile) []string {
+	var errors []string
+
+	if !nonNegative(profile.FuelConsumptionByRoad) || !nonNegative(profile.ElectricConsumptionByRoad) {
+		errors = append(errors, "Verbrauch nach Straßentyp muss >= 0 sein")
+	}
+
+	if !nonNegative(profile.DrivingMix) {
+		errors = append(errors, "Anteile der Straßentypen müssen >= 0 sein")
+	}
+
+	if km, _ := calculateTripKilometers(profile.Trips); km <= 0 && profile.DrivingMix.Total() <= 0 {
+		errors = append(errors, "Für das Fahrprofil sind Anteile der Straßentypen oder Fahrten erforderlich")
+	}
+
+	for _, trip := range profile.Trips {
+		if trip.Name == "" {
+			errors = append(errors, "Fahrten benötigen eine Bezeichnung")
+		}
+		if trip.Distance < 0 || trip.Count < 0 || !nonNegative(trip.Mix) {
+			errors = append(errors, "Strecke, Anzahl und Anteile der Fahrt "+trip.Name+" müssen >= 0 sein")
+		}
+	}
+
+	return errors
+}
+
+func nonNegative(values models.RoadTypeValues) bool {
+	return values.City >= 0 && values.Rural >= 0 && values.Motorway >= 0
+}
+
+// calculateTripKilometers returns the monthly kilometers of the trips and
+// their distance per road type in km. Trips without a road type mix are
+// counted as rural roads.
+func calculateTripKilometers(trips []models.Trip) (float64, models.RoadTypeValues) {
+	var km float64
+	var byRoad models.RoadTypeValues
+	for _, trip := range trips {
+		count := trip.Count
+		if count <= 0 {
+			count = 1
+		}
+		monthlyKm := trip.Distance * count * tripsPerMonth[trip.Frequency]
+		if monthlyKm <= 0 {
+			continue
+		}
+		km += monthlyKm
+
+		mix := trip.Mix
+		total := mix.Total()
+		if total <= 0 {
+			mix, total = models.RoadTypeValues{Rural: 100}, 100
+		}
+		byRoad.City += monthlyKm * mix.City / total
+		byRoad.Rural += monthlyKm * mix.Rural / total
+		byRoad.Motorway += monthlyKm * mix.Motorway / total
+	}
+	return km, byRoad
+}
+
+// weightedConsumption returns the consumption per 100 km over the mix (%).
+// Without any consumption per road type the fallback is returned.
+func weightedConsumption(byRoad models.RoadTypeValues, fallback float64, mix models.RoadTypeValues) float64 {
+	if byRoad.Total() <= 0 {
+		return fallback
+	}
+
+	consumption := func(value float64) float64 {
+		if value > 0 {
+			return value
+		}
+		return fallback
+	}
+	return (consumption(byRoad.City)*mix.City + consumption(byRoad.Rural)*mix.Rural +
+		consumption(byRoad.Motorway)*mix.Motorway) / 100
+}
+
+// drivenKilometers returns the monthly kilometers the profile is calculated
+// with: the kilometers of its trips if the driving profile has any.
+func drivenKilometers(profile *models.CarProfile) float64 {
+	if profile.DrivingProfile {
+		if km, _ := calculateTripKilometers(profile.Trips); km > 0 {
+			return km
+		}
+	}
+	return profile.MonthlyKilometers
+}
+
+// scaleKilometers scales the monthly kilometers and the distance of all
+// trips, so that the factor applies with and without a driving profile.
+func scaleKilometers(profile *models.CarProfile, factor float64) {
+	profile.MonthlyKilometers *= factor
+	for i := range profile.Trips {
+		profile.Trips[i].Distance *= factor
+	}
+}
+
+// scaleFuelConsumption scales the fuel consumption and the consumptions per
+// road type.
+func scaleFuelConsumption(profile *models.CarProfile, factor float64) {
+	profile.FuelConsumption *= factor
+	profile.FuelConsumptionByRoad = scaleRoadTypeValues(profile.FuelConsumptionByRoad, factor)
+}
+
+// scaleElectricConsumption scales the electric consumption and the
+// consumptions per road type.
+func scaleElectricConsumption(profile *models.CarProfile, factor float64) {
+	profile.ElectricConsumption *= factor
+	profile.ElectricConsumptionByRoad = scaleRoadTypeValues(profile.ElectricConsumptionByRoad, factor)
+}
+
+func scaleRoadTypeValues(values models.RoadTypeValues, factor float64) models.RoadTypeValues {
+	return models.RoadTypeValues{
+		City:     values.City * factor,
+		Rural:    values.Rural * factor,
+		Motorway: values.Motorway * factor,
+	}
+}
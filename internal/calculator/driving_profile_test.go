@@ -0,0 +1,85 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+
+	"auto-unterhaltsrechner/internal/models"
+)
+
+func TestDrivingProfileScaling(t *testing.T) {
+	// 25 trips of 20 km in the city per month at 8 L/100 km: 500 km and 40 L.
+	// The flat 1000 km and 5 L/100 km are replaced by the driving profile.
+	base := models.CarProfile{
+		AcquisitionType:          models.AcquisitionCash,
+		FuelType:                 models.Super,
+		FuelConsumption:          5,
+		FuelConsumptionByRoad:    models.RoadTypeValues{City: 8},
+		FuelPrice:                2,
+		MonthlyKilometers:        1000,
+		ExpectedYearsOfOwnership: 1,
+		DrivingProfile:           true,
+		Trips: []models.Trip{
+			{Name: "Einkauf", Distance: 20, Frequency: models.TripMonthly, Count: 25, Mix: models.RoadTypeValues{City: 100}},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		scale      func(*models.CarProfile, float64)
+		wantKm     float64
+		wantAmount float64
+	}{
+		{"kilometers", scaleKilometers, 550, 44},
+		{"fuel consumption", scaleFuelConsumption, 500, 44},
+		{"electric consumption", scaleElectricConsumption, 500, 40},
+		{"goal seek annual kilometers", func(p *models.CarProfile, f float64) {
+			GoalSeekAnnualKilometers.Apply(p, GoalSeekAnnualKilometers.Value(p)*f)
+		}, 550, 44},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := base.Clone()
+			tt.scale(profile, 1.1)
+			calc := newGoalSeekCalculator().CalculateCosts(profile)
+
+			if got := calc.Profile.MonthlyKilometers; math.Abs(got-tt.wantKm) > 1e-9 {
+				t.Errorf("MonthlyKilometers = %.4f, want %.4f", got, tt.wantKm)
+			}
+			if got := calc.MonthlyFuelAmount; math.Abs(got-tt.wantAmount) > 1e-9 {
+				t.Errorf("MonthlyFuelAmount = %.4f, want %.4f", got, tt.wantAmount)
+			}
+			if base.Trips[0].Distance != 20 {
+				t.Error("scaling changed the trips of the original profile")
+			}
+		})
+	}
+}
+
+func TestSensitivityDrivingProfile(t *testing.T) {
+	profile := &models.CarProfile{
+		AcquisitionType:          models.AcquisitionCash,
+		FuelType:                 models.Super,
+		FuelConsumptionByRoad:    models.RoadTypeValues{City: 8},
+		FuelPrice:                2,
+		ExpectedYearsOfOwnership: 1,
+		DrivingProfile:           true,
+		Trips: []models.Trip{
+			{Name: "Einkauf", Distance: 20, Frequency: models.TripMonthly, Count: 25, Mix: models.RoadTypeValues{City: 100}},
+		},
+	}
+
+	// 40 L × 2 € × 12 months = 960 € fuel, ± 10% through kilometers and
+	// consumption although neither flat value is entered
+	analysis := newGoalSeekCalculator().CalculateSensitivity(profile, 10)
+	swings := map[string]float64{}
+	for _, result := range analysis.Results {
+		swings[result.Parameter] = result.TCOSwing()
+	}
+	for _, parameter := range []string{"Monatliche Kilometer", "Kraftstoffverbrauch"} {
+		if got := swings[parameter]; math.Abs(got-192) > 1e-6 {
+			t.Errorf("TCO swing of %s = %.4f, want 192", parameter, got)
+		}
+	}
+}
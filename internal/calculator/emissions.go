@@ -0,0 +1,108 @@
+package calculator
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+)
+
+// Factors used for fuel and charging types without a configured factor
+const (
+	defaultFuelCO2Factor = 2.37 // kg per L, petrol
+	defaultGridCO2Factor = 363  // g per kWh, German electricity mix
+)
+
+// fuelCO2Factor returns the CO2 emissions in kg per liter of the fuel type.
+func (c *Calculator) fuelCO2Factor(fuelType models.FuelType) float64 {
+	if factor, ok := c.assumptions.FuelCO2Factors[fuelType]; ok {
+		return factor
+	}
+	if factor, ok := models.DefaultAssumptions().FuelCO2Factors[fuelType]; ok {
+		return factor
+	}
+	return defaultFuelCO2Factor
+}
+
+// gridCO2Factor returns the CO2 emissions in g per kWh drawn from the grid
+// with the given charging type.
+func (c *Calculator) gridCO2Factor(electricityType models.ElectricityType) float64 {
+	if factor, ok := c.assumptions.GridCO2Factors[electricityType]; ok {
+		return factor
+	}
+	if factor, ok := models.DefaultAssumptions().GridCO2Factors[electricityType]; ok {
+		return factor
+	}
+	return defaultGridCO2Factor
+}
+
+// calculateEmissions derives the CO2 emissions from the fuel burned and the
+// energy drawn from the grid per charging source.
+func (c *Calculator) calculateEmissions(profile *models.CarProfile, calc *models.CostCalculation) {
+	calc.MonthlyFuelCO2 = calc.MonthlyFuelAmount * c.fuelCO2Factor(profile.FuelType)
+
+	calc.MonthlyElectricityCO2 = 0
+	for _, cost := range calc.ChargingBreakdown {
+		calc.MonthlyElectricityCO2 += cost.GridEnergy * c.gridCO2Factor(cost.Type) / 1000
+	}
+
+	calc.MonthlyCO2 = calc.MonthlyFuelCO2 + calc.MonthlyElectricityCO2
+	calc.AnnualCO2 = calc.MonthlyCO2 * 12
+	calc.LifetimeCO2 = calc.MonthlyCO2 * float64(profile.ExpectedYearsOfOwnership*12)
+}
+
+// sumTimelineEmissions sums the CO2 emissions of the timeline, which follow
+// the seasonal consumption and the battery aging. Annual emissions cover the
+// first year, the monthly emissions are its average month.
+func sumTimelineEmissions(calc *models.CostCalculation) {
+	if len(calc.Timeline) == 0 {
+		return
+	}
+
+	var fuelCO2, gridCO2 float64
+	calc.LifetimeCO2 = 0
+	for i, month := range calc.Timeline {
+		if i < 12 {
+			fuelCO2 += month.FuelCO2
+			gridCO2 += month.GridCO2
+		}
+		calc.LifetimeCO2 += month.FuelCO2 + month.GridCO2
+	}
+
+	months := float64(min(len(calc.Timeline), 12))
+	calc.MonthlyFuelCO2 = fuelCO2 / months
+	calc.MonthlyElectricityCO2 = gridCO2 / months
+	calc.MonthlyCO2 = calc.MonthlyFuelCO2 + calc.MonthlyElectricityCO2
+	calc.AnnualCO2 = calc.MonthlyCO2 * 12
+}
+
+// CO2AvoidanceCost returns the additional cost in € per tonne of CO2 avoided
+// when choosing the candidate instead of the baseline, based on the cost and
+// emissions per kilometer over the ownership period. It returns false if the candidate does not emit
+// less CO2 per kilometer. A negative result means CO2 is avoided while
+// saving money.
+func CO2AvoidanceCost(candidate, baseline *models.CostCalculation) (float64, bool) {
+	if candidate == nil || baseline == nil {
+		return 0, false
+	}
+
+	candidateCO2, ok := co2PerKilometer(candidate)
+	if !ok {
+		return 0, false
+	}
+	baselineCO2, ok := co2PerKilometer(baseline)
+	if !ok || baselineCO2 <= candidateCO2 {
+		return 0, false
+	}
+
+	// kg per km to tonnes per km
+	avoided := (baselineCO2 - candidateCO2) / 1000
+	return (candidate.CostPerKilometer - baseline.CostPerKilometer) / avoided, true
+}
+
+func co2PerKilometer(calc *models.CostCalculation) (float64, bool) {
+	if calc.Profile == nil || calc.Profile.MonthlyKilometers <= 0 {
+		return 0, false
+	}
+	if len(calc.Timeline) == 0 {
+		return calc.MonthlyCO2 / calc.Profile.MonthlyKilometers, true
+	}
+	return calc.LifetimeCO2 / (calc.Profile.MonthlyKilometers * float64(len(calc.Timeline))), true
+}
@@ -0,0 +1,113 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+
+	"auto-unterhaltsrechner/internal/models"
+)
+
+// flatSeasons keeps the consumption the same in every calendar month
+var flatSeasons = []float64{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}
+
+func TestEmissions(t *testing.T) {
+	petrol := models.CarProfile{
+		FuelType:                 models.Super,
+		FuelConsumption:          5,
+		FuelPrice:                2,
+		MonthlyKilometers:        1000,
+		ExpectedYearsOfOwnership: 2,
+		SeasonalFactors:          flatSeasons,
+	}
+	electric := models.CarProfile{
+		ElectricConsumption:      20,
+		ElectricityPrice:         0.3,
+		ChargingEfficiency:       100,
+		MonthlyKilometers:        1000,
+		ExpectedYearsOfOwnership: 2,
+		SeasonalFactors:          flatSeasons,
+	}
+	agedElectric := electric
+	agedElectric.BatterySize = 60
+
+	tests := []struct {
+		name         string
+		profile      models.CarProfile
+		wantMonthly  float64
+		wantAnnual   float64
+		wantLifetime float64
+	}{
+		// 50 L × 2,37 kg/L
+		{"petrol", petrol, 118.5, 1422, 2844},
+		// 200 kWh × 363 g/kWh
+		{"electric without battery aging", electric, 72.6, 871.2, 1742.4},
+		// 1 + 25% × 0,1233% lost health per month, on average 1,0020 in
+		// the first year and 1,0039 over both years
+		{"electric with battery aging", agedElectric, 72.6 * 1.0020042, 871.2 * 1.0020042, 1742.4 * 1.0038542},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := newGoalSeekCalculator().CalculateCosts(&tt.profile)
+
+			if math.Abs(calc.MonthlyCO2-tt.wantMonthly) > 0.01 {
+				t.Errorf("MonthlyCO2 = %.3f, want %.3f", calc.MonthlyCO2, tt.wantMonthly)
+			}
+			if math.Abs(calc.AnnualCO2-tt.wantAnnual) > 0.1 {
+				t.Errorf("AnnualCO2 = %.3f, want %.3f", calc.AnnualCO2, tt.wantAnnual)
+			}
+			if math.Abs(calc.LifetimeCO2-tt.wantLifetime) > 0.1 {
+				t.Errorf("LifetimeCO2 = %.3f, want %.3f", calc.LifetimeCO2, tt.wantLifetime)
+			}
+			if got := calc.MonthlyFuelCO2 + calc.MonthlyElectricityCO2; math.Abs(got-calc.MonthlyCO2) > 1e-9 {
+				t.Errorf("fuel and grid CO2 = %.3f, want MonthlyCO2 %.3f", got, calc.MonthlyCO2)
+			}
+		})
+	}
+}
+
+func TestCO2AvoidanceCost(t *testing.T) {
+	// 0,10 €/km and 118,5 g/km
+	petrol := &models.CarProfile{
+		FuelType:                 models.Super,
+		FuelConsumption:          5,
+		FuelPrice:                2,
+		MonthlyKilometers:        1000,
+		ExpectedYearsOfOwnership: 2,
+	}
+	// 0,06 €/km and 72,6 g/km
+	electric := &models.CarProfile{
+		ElectricConsumption:      20,
+		ElectricityPrice:         0.3,
+		ChargingEfficiency:       100,
+		MonthlyKilometers:        1000,
+		ExpectedYearsOfOwnership: 2,
+	}
+
+	c := newGoalSeekCalculator()
+	petrolCalc := c.CalculateCosts(petrol)
+	electricCalc := c.CalculateCosts(electric)
+
+	tests := []struct {
+		name      string
+		candidate *models.CostCalculation
+		baseline  *models.CostCalculation
+		want      float64
+		wantOK    bool
+	}{
+		// -0,04 € ÷ 0,0000459 t per km
+		{"electric instead of petrol", electricCalc, petrolCalc, -0.04 / 0.0000459, true},
+		{"petrol instead of electric", petrolCalc, electricCalc, 0, false},
+		{"same car", petrolCalc, petrolCalc, 0, false},
+		{"missing calculation", nil, petrolCalc, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := CO2AvoidanceCost(tt.candidate, tt.baseline)
+			if ok != tt.wantOK || math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("CO2AvoidanceCost() = %.4f, %v, want %.4f, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package calculator
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+	"math"
+)
+
+// usesLoan reports whether the financing is modelled as a loan. Without an
+// interest rate, down payment or balloon payment the entered FinancingRate
+// is used as is.
+func usesLoan(profile *models.CarProfile) bool {
+	if profile.AcquisitionType == models.AcquisitionCash || usesLease(profile) {
+		return false
+	}
+
+	return profile.FinancingPeriod > 0 && profile.PurchasePrice > 0 &&
+		(profile.InterestRate > 0 || profile.DownPayment > 0 || profile.BalloonPayment > 0)
+}
+
+func usesLease(profile *models.CarProfile) bool {
+	return profile.AcquisitionType == models.AcquisitionLease
+}
+
+// leaseMonths returns the lease term, defaulting to the whole ownership period.
+func leaseMonths(profile *models.CarProfile) int {
+	ownershipMonths := profile.ExpectedYearsOfOwnership * 12
+	if profile.FinancingPeriod <= 0 || profile.FinancingPeriod > ownershipMonths {
+		return max(ownershipMonths, 0)
+	}
+	return profile.FinancingPeriod
+}
+
+// calculateLeaseSettlement returns the kilometers driven above (or below)
+// the contracted kilometers over the lease term and the resulting payment
+// at its end. Unused kilometers are refunded as a negative payment.
+func (c *Calculator) calculateLeaseSettlement(profile *models.CarProfile) (float64, float64) {
+	months := float64(leaseMonths(profile))
+	if profile.LeaseAnnualKilometers <= 0 || months <= 0 {
+		return 0, 0
+	}
+
+	excessKm := profile.MonthlyKilometers*months - profile.LeaseAnnualKilometers*months/12
+	if excessKm > 0 {
+		return excessKm, excessKm * profile.LeaseExcessKmCost
+	}
+	return excessKm, excessKm * profile.LeaseUnusedKmCredit
+}
+
+// monthlyInterestRate converts an effective annual rate in percent
+// (Effektivzins) to the equivalent monthly rate.
+func monthlyInterestRate(effectiveAnnualRate float64) float64 {
+	return math.Pow(1+effectiveAnnualRate/100, 1.0/12) - 1
+}
+
+// loanRate returns the monthly annuity of a loan that still has the
+// balloon payment outstanding after the given number of months.
+func loanRate(amount, effectiveAnnualRate float64, months int, balloon float64) float64 {
+	if months <= 0 {
+		return 0
+	}
+
+	i := monthlyInterestRate(effectiveAnnualRate)
+	if i == 0 {
+		return (amount - balloon) / float64(months)
+	}
+
+	discount := math.Pow(1+i, -float64(months))
+	return (amount - balloon*discount) * i / (1 - discount)
+}
+
+// calculateAmortizationSchedule returns the loan amount and the full
+// schedule over the financing period. The last payment includes the
+// balloon payment.
+func (c *Calculator) calculateAmortizationSchedule(profile *models.CarProfile) (float64, []models.LoanPayment) {
+	amount := math.Max(profile.PurchasePrice-profile.DownPayment, 0)
+	months := profile.FinancingPeriod
+	rate := loanRate(amount, profile.InterestRate, months, profile.BalloonPayment)
+	i := monthlyInterestRate(profile.InterestRate)
+
+	schedule := make([]models.LoanPayment, 0, months)
+	balance := amount
+	for month := 1; month <= months; month++ {
+		interest := balance * i
+		payment := rate
+		if month == months {
+			// Pay off the remaining balance, i.e. the balloon payment
+			payment = balance + interest
+		}
+
+		principal := payment - interest
+		balance -= principal
+		schedule = append(schedule, models.LoanPayment{
+			Month:     month,
+			Payment:   payment,
+			Interest:  interest,
+			Principal: principal,
+			Balance:   math.Max(balance, 0),
+		})
+	}
+
+	return amount, schedule
+}
+
+// monthlyFinancing returns the financing payment due in the given month of
+// the ownership period. A loan running beyond the ownership period is paid
+// off in its last month, a lease is settled in its last month.
+func (c *Calculator) monthlyFinancing(profile *models.CarProfile, calc *models.CostCalculation, month int) float64 {
+	if month > calc.FinancingMonths {
+		return 0
+	}
+
+	if usesLease(profile) && month == calc.FinancingMonths {
+		return profile.FinancingRate + calc.LeaseSettlement
+	}
+
+	if len(calc.AmortizationSchedule) == 0 {
+		return profile.FinancingRate
+	}
+
+	payment := calc.AmortizationSchedule[month-1]
+	if month == profile.ExpectedYearsOfOwnership*12 {
+		return payment.Payment + payment.Balance
+	}
+	return payment.Payment
+}
@@ -0,0 +1,170 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+
+	"auto-unterhaltsrechner/internal/models"
+)
+
+// onePercentPerMonth is the effective annual rate equivalent to exactly 1%
+// interest per month.
+var onePercentPerMonth = (math.Pow(1.01, 12) - 1) * 100
+
+func TestLoanRate(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  float64
+		rate    float64
+		months  int
+		balloon float64
+		want    float64
+	}{
+		{"zero rate", 12000, 0, 12, 0, 1000},
+		{"zero rate with balloon", 12000, 0, 12, 2400, 800},
+		// 10000 × 0,01 ÷ (1 - 1,01^-12)
+		{"annuity", 10000, onePercentPerMonth, 12, 0, 888.49},
+		// (10000 - 5000 × 1,01^-12) × 0,01 ÷ (1 - 1,01^-12)
+		{"annuity with balloon", 10000, onePercentPerMonth, 12, 5000, 494.24},
+		{"no term", 10000, 5, 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := loanRate(tt.amount, tt.rate, tt.months, tt.balloon); math.Abs(got-tt.want) > 0.01 {
+				t.Errorf("loanRate() = %.2f, want %.2f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalculateAmortizationSchedule(t *testing.T) {
+	tests := []struct {
+		name        string
+		profile     *models.CarProfile
+		wantAmount  float64
+		wantPayment float64 // regular monthly payment
+		wantLast    float64 // last payment including the balloon
+	}{
+		{"zero rate", &models.CarProfile{PurchasePrice: 14000, DownPayment: 2000, FinancingPeriod: 12},
+			12000, 1000, 1000},
+		{"zero rate with balloon", &models.CarProfile{PurchasePrice: 12000, BalloonPayment: 2400, FinancingPeriod: 12},
+			12000, 800, 3200},
+		{"annuity with balloon", &models.CarProfile{PurchasePrice: 10000, InterestRate: onePercentPerMonth,
+			BalloonPayment: 5000, FinancingPeriod: 12},
+			10000, 494.24, 494.24 + 5000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount, schedule := New().calculateAmortizationSchedule(tt.profile)
+			if amount != tt.wantAmount {
+				t.Errorf("amount = %.2f, want %.2f", amount, tt.wantAmount)
+			}
+			if len(schedule) != tt.profile.FinancingPeriod {
+				t.Fatalf("len(schedule) = %d, want %d", len(schedule), tt.profile.FinancingPeriod)
+			}
+			if got := schedule[0].Payment; math.Abs(got-tt.wantPayment) > 0.01 {
+				t.Errorf("first payment = %.2f, want %.2f", got, tt.wantPayment)
+			}
+			last := schedule[len(schedule)-1]
+			if math.Abs(last.Payment-tt.wantLast) > 0.01 {
+				t.Errorf("last payment = %.2f, want %.2f", last.Payment, tt.wantLast)
+			}
+			if last.Balance > 0.01 {
+				t.Errorf("final balance = %.2f, want 0", last.Balance)
+			}
+
+			var principal float64
+			for _, payment := range schedule {
+				principal += payment.Principal
+			}
+			if math.Abs(principal-amount) > 0.01 {
+				t.Errorf("total principal = %.2f, want %.2f", principal, amount)
+			}
+		})
+	}
+}
+
+func TestMonthlyFinancingPayoffAtSale(t *testing.T) {
+	// 20000 € over 48 months at 1% per month, the car is sold after 24
+	// months: rate 526,68 € plus the remaining balance of 11188,40 €
+	profile := &models.CarProfile{
+		AcquisitionType:          models.AcquisitionLoan,
+		PurchasePrice:            20000,
+		InterestRate:             onePercentPerMonth,
+		FinancingPeriod:          48,
+		ExpectedYearsOfOwnership: 2,
+	}
+
+	calc := New().CalculateCosts(profile)
+	if got, want := calc.FinancingMonths, 24; got != want {
+		t.Fatalf("FinancingMonths = %d, want %d", got, want)
+	}
+	if got, want := calc.Timeline[0].Financing, 526.68; math.Abs(got-want) > 0.01 {
+		t.Errorf("financing in month 1 = %.2f, want %.2f", got, want)
+	}
+	if got, want := calc.Timeline[23].Financing, 526.68+11188.40; math.Abs(got-want) > 0.01 {
+		t.Errorf("financing in month 24 = %.2f, want %.2f", got, want)
+	}
+}
+
+func TestCalculateLeaseSettlement(t *testing.T) {
+	tests := []struct {
+		name           string
+		monthlyKm      float64
+		contractKm     float64
+		wantKilometers float64
+		wantSettlement float64
+	}{
+		// 36 months: 54000 km driven against 45000 km contracted
+		{"excess kilometers", 1500, 15000, 9000, 900},
+		// 36 months: 36000 km driven against 45000 km contracted
+		{"unused kilometers", 1000, 15000, -9000, -450},
+		{"no contract kilometers", 1000, 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := &models.CarProfile{
+				AcquisitionType:          models.AcquisitionLease,
+				MonthlyKilometers:        tt.monthlyKm,
+				FinancingPeriod:          36,
+				LeaseAnnualKilometers:    tt.contractKm,
+				LeaseExcessKmCost:        0.10,
+				LeaseUnusedKmCredit:      0.05,
+				ExpectedYearsOfOwnership: 4,
+			}
+
+			km, settlement := New().calculateLeaseSettlement(profile)
+			if math.Abs(km-tt.wantKilometers) > 0.01 {
+				t.Errorf("kilometers = %.2f, want %.2f", km, tt.wantKilometers)
+			}
+			if math.Abs(settlement-tt.wantSettlement) > 0.01 {
+				t.Errorf("settlement = %.2f, want %.2f", settlement, tt.wantSettlement)
+			}
+		})
+	}
+}
+
+func TestMonthlyFinancingLeaseSettlement(t *testing.T) {
+	// 300 € per month over 36 months, 9000 excess km at 0,10 € settled
+	// with the last rate
+	profile := &models.CarProfile{
+		AcquisitionType:          models.AcquisitionLease,
+		MonthlyKilometers:        1500,
+		FinancingRate:            300,
+		FinancingPeriod:          36,
+		LeaseAnnualKilometers:    15000,
+		LeaseExcessKmCost:        0.10,
+		ExpectedYearsOfOwnership: 3,
+	}
+
+	calc := New().CalculateCosts(profile)
+	if got, want := calc.Timeline[34].Financing, 300.0; got != want {
+		t.Errorf("financing in month 35 = %.2f, want %.2f", got, want)
+	}
+	if got, want := calc.Timeline[35].Financing, 1200.0; math.Abs(got-want) > 0.01 {
+		t.Errorf("financing in month 36 = %.2f, want %.2f", got, want)
+	}
+}
@@ -0,0 +1,183 @@
+package calculator
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+	"errors"
+	"math"
+	"slices"
+)
+
+// GoalSeekInput is the profile input solved for by GoalSeek.
+type GoalSeekInput string
+
+const (
+	GoalSeekPurchasePrice    GoalSeekInput = "purchase_price"
+	GoalSeekAnnualKilometers GoalSeekInput = "annual_km"
+	GoalSeekFuelPrice        GoalSeekInput = "fuel_price"
+	GoalSeekElectricityPrice GoalSeekInput = "electricity_price"
+	GoalSeekAnnualInsurance  GoalSeekInput = "annual_insurance"
+	GoalSeekFinancingRate    GoalSeekInput = "financing_rate"
+)
+
+// GoalSeekMetric is the result GoalSeek matches to the target value.
+type GoalSeekMetric string
+
+const (
+	GoalSeekMonthlyCost GoalSeekMetric = "monthly_cost" // total cost of ownership per month
+	GoalSeekTotalCost   GoalSeekMetric = "total_cost"
+	GoalSeekCostPerKm   GoalSeekMetric = "cost_per_km"
+)
+
+func GetGoalSeekInputs() []GoalSeekInput {
+	return []GoalSeekInput{GoalSeekPurchasePrice, GoalSeekAnnualKilometers, GoalSeekFuelPrice,
+		GoalSeekElectricityPrice, GoalSeekAnnualInsurance, GoalSeekFinancingRate}
+}
+
+func GetGoalSeekMetrics() []GoalSeekMetric {
+	return []GoalSeekMetric{GoalSeekMonthlyCost, GoalSeekTotalCost, GoalSeekCostPerKm}
+}
+
+var (
+	ErrUnknownGoalSeekInput = errors.New("unknown goal seek input")
+	ErrGoalNotReachable     = errors.New("target value cannot be reached")
+)
+
+const (
+	// goalSeekMaxExpansions limits the doubling of the search range
+	goalSeekMaxExpansions = 40
+
+	// goalSeekIterations is enough bisection steps to reach floating point
+	// precision on any search range
+	goalSeekIterations = 100
+)
+
+// Value returns the current value of the input in the profile. The
+// kilometers of a driving profile with trips are those of the trips, the
+// electricity price of a charging mix is the share-weighted price.
+func (input GoalSeekInput) Value(profile *models.CarProfile) float64 {
+	switch input {
+	case GoalSeekPurchasePrice:
+		return profile.PurchasePrice
+	case GoalSeekAnnualKilometers:
+		return drivenKilometers(profile) * 12
+	case GoalSeekFuelPrice:
+		return profile.FuelPrice
+	case GoalSeekElectricityPrice:
+		return averageElectricityPrice(profile)
+	case GoalSeekAnnualInsurance:
+		return insurancePremium(profile, 1).Premium
+	case GoalSeekFinancingRate:
+		return profile.FinancingRate
+	}
+	return 0
+}
+
+// Apply sets the input in the profile. The distances of the trips and the
+// prices of a charging mix are scaled so that their kilometers and
+// share-weighted price match the value.
+func (input GoalSeekInput) Apply(profile *models.CarProfile, value float64) {
+	switch input {
+	case GoalSeekPurchasePrice:
+		profile.PurchasePrice = value
+	case GoalSeekAnnualKilometers:
+		if km := drivenKilometers(profile); km > 0 {
+			scaleKilometers(profile, value/12/km)
+		}
+		profile.MonthlyKilometers = value / 12
+	case GoalSeekFuelPrice:
+		profile.FuelPrice = value
+	case GoalSeekElectricityPrice:
+		if len(profile.ChargingSources) == 0 {
+			profile.ElectricityPrice = value
+			return
+		}
+		if current := input.Value(profile); current > 0 {
+			scaleElectricityPrice(profile, value/current)
+			return
+		}
+		for i := range profile.ChargingSources {
+			profile.ChargingSources[i].Price = value
+		}
+	case GoalSeekAnnualInsurance:
+		if current := input.Value(profile); profile.InsuranceModel && current > 0 {
+			scaleInsurance(profile, value/current)
+			return
+		}
+		profile.AnnualCarInsurance = value
+	case GoalSeekFinancingRate:
+		profile.FinancingRate = value
+	}
+}
+
+// GoalSeekValue returns the metric of a calculation. The cost per km of a
+// car that is not driven is infinite, so that the search does not mistake
+// the jump to zero for the target.
+func GoalSeekValue(calc *models.CostCalculation, metric GoalSeekMetric) float64 {
+	switch metric {
+	case GoalSeekTotalCost:
+		return calc.TotalCostOfOwnership
+	case GoalSeekCostPerKm:
+		if calc.CostPerKilometer == 0 && calc.Profile.MonthlyKilometers <= 0 {
+			return math.Inf(1)
+		}
+		return calc.CostPerKilometer
+	default:
+		if len(calc.Timeline) == 0 {
+			return 0
+		}
+		return calc.TotalCostOfOwnership / float64(len(calc.Timeline))
+	}
+}
+
+// GoalSeek returns the value of the input at which the metric of the
+// profile reaches the target, e.g. the maximum purchase price for a monthly
+// budget or the electricity price at which an electric car costs as much as
+// a combustion car. The search starts at 0 and doubles the range until the
+// target lies within it, then bisects. The profile is not changed.
+func (c *Calculator) GoalSeek(profile *models.CarProfile, input GoalSeekInput, metric GoalSeekMetric, target float64) (float64, error) {
+	if !slices.Contains(GetGoalSeekInputs(), input) {
+		return 0, ErrUnknownGoalSeekInput
+	}
+	if math.IsInf(target, 0) || math.IsNaN(target) {
+		return 0, ErrGoalNotReachable
+	}
+
+	// Difference to the target with the input set to value
+	deviation := func(value float64) float64 {
+		scaled := profile.Clone()
+		input.Apply(scaled, value)
+		return GoalSeekValue(c.CalculateCosts(scaled), metric) - target
+	}
+
+	low, high := 0.0, math.Max(input.Value(profile)*2, 1)
+	lowDeviation := deviation(low)
+	if lowDeviation == 0 {
+		return low, nil
+	}
+
+	highDeviation := deviation(high)
+	for expansions := 0; sameSign(lowDeviation, highDeviation); expansions++ {
+		if expansions == goalSeekMaxExpansions {
+			return 0, ErrGoalNotReachable
+		}
+		low, lowDeviation = high, highDeviation
+		high *= 2
+		highDeviation = deviation(high)
+	}
+
+	for i := 0; i < goalSeekIterations && high-low > 1e-9*math.Max(high, 1); i++ {
+		mid := (low + high) / 2
+		midDeviation := deviation(mid)
+		if sameSign(lowDeviation, midDeviation) {
+			low, lowDeviation = mid, midDeviation
+		} else {
+			high = mid
+		}
+	}
+
+	return (low + high) / 2, nil
+}
+
+func sameSign(a, b float64) bool {
+	return (a > 0) == (b > 0) && a != 0 && b != 0
+}
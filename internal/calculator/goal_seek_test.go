@@ -0,0 +1,122 @@
+package calculator
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"auto-unterhaltsrechner/internal/models"
+)
+
+// newGoalSeekCalculator returns a calculator without CO2 price path, so that
+// the fuel costs stay the same over the year.
+func newGoalSeekCalculator() *Calculator {
+	c := New()
+	assumptions := models.DefaultAssumptions()
+	assumptions.CO2Prices = map[int]float64{}
+	c.SetAssumptions(assumptions)
+	return c
+}
+
+func TestGoalSeek(t *testing.T) {
+	// 5 L/100 km at 2,00 €/L and 1000 km per month: 100 € fuel per month
+	base := models.CarProfile{
+		AcquisitionType:          models.AcquisitionCash,
+		FuelType:                 models.Super,
+		FuelConsumption:          5,
+		FuelPrice:                2,
+		MonthlyKilometers:        1000,
+		ExpectedYearsOfOwnership: 1,
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*models.CarProfile)
+		input  GoalSeekInput
+		metric GoalSeekMetric
+		target float64
+		want   float64
+	}{
+		// 50 L per month × price = 90 €
+		{"fuel price for monthly budget", nil, GoalSeekFuelPrice, GoalSeekMonthlyCost, 90, 1.80},
+		// 100 € fuel + rate = 350 €
+		{"financing rate for monthly budget", func(p *models.CarProfile) {
+			p.AcquisitionType = models.AcquisitionLoan
+			p.FinancingPeriod = 12
+		}, GoalSeekFinancingRate, GoalSeekMonthlyCost, 350, 250},
+		// 1200 € fuel + insurance = 3600 €
+		{"insurance for total cost", nil, GoalSeekAnnualInsurance, GoalSeekTotalCost, 3600, 2400},
+		// (100 € insurance + 0,10 €/km × km) ÷ km = 0,15 €/km at 2000 km per month
+		{"annual kilometers for cost per km", func(p *models.CarProfile) {
+			p.AnnualCarInsurance = 1200
+		}, GoalSeekAnnualKilometers, GoalSeekCostPerKm, 0.15, 24000},
+		// 0,125 €/km at 4000 km per month, beyond the initial search range
+		{"annual kilometers beyond search range", func(p *models.CarProfile) {
+			p.AnnualCarInsurance = 1200
+		}, GoalSeekAnnualKilometers, GoalSeekCostPerKm, 0.125, 48000},
+		// 80% linear depreciation + 1200 € fuel = 9200 €
+		{"purchase price for total cost", nil, GoalSeekPurchasePrice, GoalSeekTotalCost, 9200, 10000},
+		// The SF class does not change in the first year
+		{"insurance model for total cost", func(p *models.CarProfile) {
+			p.InsuranceModel = true
+			p.InsuranceCoverage = models.CoverageComprehensive
+			p.InsuranceSFClass = 5
+			p.InsuranceLiabilityPremium = 400
+			p.InsuranceFullPremium = 400
+		}, GoalSeekAnnualInsurance, GoalSeekTotalCost, 2400, 1200},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := base
+			if tt.modify != nil {
+				tt.modify(&profile)
+			}
+			before := profile
+
+			got, err := newGoalSeekCalculator().GoalSeek(&profile, tt.input, tt.metric, tt.target)
+			if err != nil {
+				t.Fatalf("GoalSeek() error = %v", err)
+			}
+			if math.Abs(got-tt.want) > 1e-4*math.Max(tt.want, 1) {
+				t.Errorf("GoalSeek() = %.4f, want %.4f", got, tt.want)
+			}
+			if !reflect.DeepEqual(profile, before) {
+				t.Error("GoalSeek() changed the profile")
+			}
+		})
+	}
+}
+
+func TestGoalSeekErrors(t *testing.T) {
+	profile := &models.CarProfile{
+		FuelType:                 models.Super,
+		FuelConsumption:          5,
+		FuelPrice:                2,
+		MonthlyKilometers:        1000,
+		ExpectedYearsOfOwnership: 1,
+	}
+
+	tests := []struct {
+		name    string
+		input   GoalSeekInput
+		target  float64
+		wantErr error
+	}{
+		// The costs never fall below zero, however low the fuel price
+		{"target without root", GoalSeekFuelPrice, -10, ErrGoalNotReachable},
+		{"unknown input", GoalSeekInput("tire_cost"), 100, ErrUnknownGoalSeekInput},
+		// Cost per km of a comparison car that is not driven
+		{"infinite target", GoalSeekFuelPrice, math.Inf(1), ErrGoalNotReachable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newGoalSeekCalculator().GoalSeek(profile, tt.input, GoalSeekMonthlyCost, tt.target)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("GoalSeek() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
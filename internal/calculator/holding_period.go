@@ -0,0 +1,56 @@
+package calculator
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+)
+
+// maxHoldingPeriodYears is the longest ownership period compared
+const maxHoldingPeriodYears = 15
+
+// HoldingPeriod is the cost of keeping the car for a number of years.
+type HoldingPeriod struct {
+	Years                int     `json:"years"`
+	TotalCostOfOwnership float64 `json:"total_cost_of_ownership"`
+	EquivalentAnnualCost float64 `json:"equivalent_annual_cost"`
+	CostPerKilometer     float64 `json:"cost_per_kilometer"`
+	ResidualValue        float64 `json:"residual_value"`
+}
+
+// HoldingPeriodAnalysis compares ownership periods of a profile.
+type HoldingPeriodAnalysis struct {
+	Profile      *models.CarProfile `json:"profile"`
+	Periods      []HoldingPeriod    `json:"periods"` // 1 to 15 years
+	OptimalYears int                `json:"optimal_years"`
+}
+
+// CalculateHoldingPeriods calculates the profile for every ownership period
+// from 1 to 15 years and returns the one with the lowest equivalent annual
+// cost. Early years carry the steep depreciation and the financing, later
+// years the maintenance rising with the age of the car.
+func (c *Calculator) CalculateHoldingPeriods(profile *models.CarProfile) *HoldingPeriodAnalysis {
+	if profile == nil {
+		return nil
+	}
+
+	analysis := &HoldingPeriodAnalysis{Profile: profile}
+	for years := 1; years <= maxHoldingPeriodYears; years++ {
+		scaled := profile.Clone()
+		scaled.ExpectedYearsOfOwnership = years
+		calc := c.CalculateCosts(scaled)
+
+		period := HoldingPeriod{
+			Years:                years,
+			TotalCostOfOwnership: calc.TotalCostOfOwnership,
+			EquivalentAnnualCost: calc.EquivalentAnnualCost,
+			CostPerKilometer:     calc.CostPerKilometer,
+			ResidualValue:        calc.ResidualValue,
+		}
+		analysis.Periods = append(analysis.Periods, period)
+
+		if analysis.OptimalYears == 0 || period.EquivalentAnnualCost < analysis.Periods[analysis.OptimalYears-1].EquivalentAnnualCost {
+			analysis.OptimalYears = years
+		}
+	}
+
+	return analysis
+}
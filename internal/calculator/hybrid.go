@@ -0,0 +1,67 @@
+package calculator
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+	"math"
+)
+
+// workdaysPerMonth is used to derive the commuting distance per month
+const workdaysPerMonth = 220.0 / 12
+
+// defaultElectricShare is the share (0-1) of the kilometers a plug-in
+// hybrid drives electrically if neither a share nor the daily commute is
+// entered, a typical value for privately used plug-in hybrids.
+const defaultElectricShare = 0.45
+
+// isPlugInHybrid reports whether the profile has both a fuel and an
+// electric consumption.
+func isPlugInHybrid(profile *models.CarProfile) bool {
+	return profile.FuelConsumption > 0 && profile.ElectricConsumption > 0
+}
+
+// hasElectricShare reports whether an electric share is entered. Profiles
+// saved before ElectricShareSet existed only set a share above 0.
+func hasElectricShare(profile *models.CarProfile) bool {
+	return profile.ElectricShareSet || profile.ElectricShare > 0
+}
+
+// calculateElectricShare returns the share (0-1) of the monthly kilometers
+// driven electrically. Pure combustion cars return 0, pure electric cars 1.
+// For plug-in hybrids the entered share is used, otherwise it is derived
+// from the daily commute or, without either, defaultElectricShare applies.
+func (c *Calculator) calculateElectricShare(profile *models.CarProfile) float64 {
+	switch {
+	case !isPlugInHybrid(profile):
+		if profile.ElectricConsumption > 0 {
+			return 1
+		}
+		return 0
+	case hasElectricShare(profile):
+		return math.Min(math.Max(profile.ElectricShare/100, 0), 1)
+	}
+
+	if share, ok := commuteElectricShare(profile); ok {
+		return share
+	}
+	return defaultElectricShare
+}
+
+// commuteElectricShare derives the electric share (0-1) of a plug-in hybrid
+// from the electric range and the daily commute, assuming the car is charged
+// once per workday and all other trips are driven on fuel.
+func commuteElectricShare(profile *models.CarProfile) (float64, bool) {
+	if profile.DailyCommuteKm <= 0 || profile.BatterySize <= 0 || profile.MonthlyKilometers <= 0 {
+		return 0, false
+	}
+
+	electricRange := profile.BatterySize / profile.ElectricConsumption * 100
+	electricKm := math.Min(electricRange, profile.DailyCommuteKm) * workdaysPerMonth
+	return math.Min(electricKm/profile.MonthlyKilometers, 1), true
+}
+
+// splitKilometers returns the monthly kilometers driven on fuel and
+// electrically, which always add up to the monthly kilometers.
+func (c *Calculator) splitKilometers(profile *models.CarProfile) (float64, float64) {
+	share := c.calculateElectricShare(profile)
+	return profile.MonthlyKilometers * (1 - share), profile.MonthlyKilometers * share
+}
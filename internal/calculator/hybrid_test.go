@@ -0,0 +1,72 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+
+	"auto-unterhaltsrechner/internal/models"
+)
+
+func TestSplitKilometers(t *testing.T) {
+	tests := []struct {
+		name           string
+		profile        models.CarProfile
+		wantElectricKm float64
+	}{
+		{"combustion", models.CarProfile{FuelConsumption: 6}, 0},
+		{"electric", models.CarProfile{ElectricConsumption: 18}, 1500},
+		{"plug-in hybrid with share", models.CarProfile{FuelConsumption: 6, ElectricConsumption: 20,
+			ElectricShare: 60}, 900},
+		{"plug-in hybrid with 0% share", models.CarProfile{FuelConsumption: 6, ElectricConsumption: 20,
+			ElectricShareSet: true, DailyCommuteKm: 40, BatterySize: 12}, 0},
+		// min(60 km range, 40 km) × 220 ÷ 12 = 733,33 km
+		{"plug-in hybrid from commute", models.CarProfile{FuelConsumption: 6, ElectricConsumption: 20,
+			DailyCommuteKm: 40, BatterySize: 12}, 733.33},
+		// min(30 km range, 40 km) × 220 ÷ 12 = 550 km
+		{"plug-in hybrid with short range", models.CarProfile{FuelConsumption: 6, ElectricConsumption: 20,
+			DailyCommuteKm: 40, BatterySize: 6}, 550},
+		{"plug-in hybrid with long commute", models.CarProfile{FuelConsumption: 6, ElectricConsumption: 20,
+			DailyCommuteKm: 200, BatterySize: 100}, 1500},
+		{"plug-in hybrid without share or commute", models.CarProfile{FuelConsumption: 6, ElectricConsumption: 20},
+			1500 * defaultElectricShare},
+		{"share above 100%", models.CarProfile{FuelConsumption: 6, ElectricConsumption: 20,
+			ElectricShare: 120}, 1500},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := tt.profile
+			profile.MonthlyKilometers = 1500
+
+			fuelKm, electricKm := New().splitKilometers(&profile)
+			if math.Abs(fuelKm+electricKm-profile.MonthlyKilometers) > 1e-9 {
+				t.Errorf("fuel km + electric km = %.2f, want MonthlyKilometers %.2f", fuelKm+electricKm, profile.MonthlyKilometers)
+			}
+			if math.Abs(electricKm-tt.wantElectricKm) > 0.01 {
+				t.Errorf("electric km = %.2f, want %.2f", electricKm, tt.wantElectricKm)
+			}
+		})
+	}
+}
+
+func TestCalculateCostsPlugInHybridEnergy(t *testing.T) {
+	// 40% of 1000 km electrically: 600 km × 6 L/100 km and 400 km × 20 kWh/100 km
+	profile := &models.CarProfile{
+		FuelConsumption:          6,
+		ElectricConsumption:      20,
+		ElectricShare:            40,
+		MonthlyKilometers:        1000,
+		ExpectedYearsOfOwnership: 1,
+	}
+
+	calc := New().CalculateCosts(profile)
+	if got, want := calc.MonthlyFuelAmount, 36.0; math.Abs(got-want) > 1e-9 {
+		t.Errorf("MonthlyFuelAmount = %.2f, want %.2f", got, want)
+	}
+	if got, want := calc.MonthlyElectricityAmount, 80.0; math.Abs(got-want) > 1e-9 {
+		t.Errorf("MonthlyElectricityAmount = %.2f, want %.2f", got, want)
+	}
+	if got, want := calc.ElectricShare, 40.0; got != want {
+		t.Errorf("ElectricShare = %.2f, want %.2f", got, want)
+	}
+}
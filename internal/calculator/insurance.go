@@ -0,0 +1,98 @@
+package calculator
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+)
+
+// maxSFClass is the highest Schadenfreiheitsklasse, later claim-free years
+// keep its rate.
+const maxSFClass = 35
+
+// sfClassRates are typical premium rates in % per Schadenfreiheitsklasse,
+// from SF 0 to SF 35. Only the ratio between two classes is used.
+var sfClassRates = [maxSFClass + 1]float64{
+	100, 77, 69, 63, 58, 54, 51, 48, 46, 44,
+	42, 41, 40, 38, 37, 36, 35, 34, 33, 32,
+	31, 30, 30, 29, 29, 28, 28, 27, 27, 26,
+	26, 26, 25, 25, 25, 25,
+}
+
+func sfClassRate(class int) float64 {
+	return sfClassRates[min(max(class, 0), maxSFClass)]
+}
+
+// insurancePremium returns the insurance premium of the given year of
+// ownership before the price escalation. With the insurance model the car
+// climbs one SF class per claim-free year, which lowers the Haftpflicht and
+// Vollkasko premiums, and optionally drops from Vollkasko to Teilkasko.
+// Otherwise the annual insurance applies to every year.
+func insurancePremium(profile *models.CarProfile, year int) models.InsuranceYear {
+	if !profile.InsuranceModel {
+		return models.InsuranceYear{Year: year, Premium: profile.AnnualCarInsurance}
+	}
+
+	coverage := profile.InsuranceCoverage
+	if coverage == "" {
+		coverage = models.CoverageLiability
+	}
+	if coverage == models.CoverageComprehensive && profile.InsuranceSwitchYears > 0 && year > profile.InsuranceSwitchYears {
+		coverage = models.CoveragePartial
+	}
+
+	sfClass := min(profile.InsuranceSFClass+year-1, maxSFClass)
+	factor := sfClassRate(sfClass) / sfClassRate(profile.InsuranceSFClass)
+
+	premium := profile.InsuranceLiabilityPremium * factor
+	switch coverage {
+	case models.CoveragePartial:
+		premium += profile.InsurancePartialPremium
+	case models.CoverageComprehensive:
+		premium += profile.InsuranceFullPremium * factor
+	}
+
+	return models.InsuranceYear{Year: year, SFClass: sfClass, Coverage: coverage, Premium: premium}
+}
+
+// calculateInsurancePremiums returns the premium path over the ownership
+// period.
+func (c *Calculator) calculateInsurancePremiums(profile *models.CarProfile) []models.InsuranceYear {
+	premiums := make([]models.InsuranceYear, 0, profile.ExpectedYearsOfOwnership)
+	for year := 1; year <= profile.ExpectedYearsOfOwnership; year++ {
+		premiums = append(premiums, insurancePremium(profile, year))
+	}
+	return premiums
+}
+
+// scaleInsurance multiplies the annual insurance or, with the insurance
+// model, all premiums by factor.
+func scaleInsurance(profile *models.CarProfile, factor float64) {
+	if !profile.InsuranceModel {
+		profile.AnnualCarInsurance *= factor
+		return
+	}
+	profile.InsuranceLiabilityPremium *= factor
+	profile.InsurancePartialPremium *= factor
+	profile.InsuranceFullPremium *= factor
+}
+
+func validateInsurance(profile *models.CarProfile) []string {
+	if !profile.InsuranceModel {
+		return nil
+	}
+
+	var errors []string
+
+	if profile.InsuranceSFClass < 0 || profile.InsuranceSFClass > maxSFClass {
+		errors = append(errors, "SF-Klasse muss zwischen 0 und 35 liegen")
+	}
+
+	if profile.InsuranceLiabilityPremium < 0 || profile.InsurancePartialPremium < 0 || profile.InsuranceFullPremium < 0 {
+		errors = append(errors, "Versicherungsbeiträge müssen >= 0 sein")
+	}
+
+	if profile.InsuranceSwitchYears < 0 {
+		errors = append(errors, "Wechsel zur Teilkasko muss >= 0 Jahre sein")
+	}
+
+	return errors
+}
@@ -0,0 +1,96 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+
+	"auto-unterhaltsrechner/internal/models"
+)
+
+func TestInsurancePremium(t *testing.T) {
+	// 400 € Haftpflicht and 600 € Vollkasko at SF 5 (rate 54%)
+	base := models.CarProfile{
+		InsuranceModel:            true,
+		InsuranceSFClass:          5,
+		InsuranceLiabilityPremium: 400,
+		InsurancePartialPremium:   150,
+		InsuranceFullPremium:      600,
+	}
+
+	tests := []struct {
+		name        string
+		modify      func(*models.CarProfile)
+		year        int
+		wantSFClass int
+		wantCover   models.InsuranceCoverage
+		wantPremium float64
+	}{
+		{"liability at purchase", nil, 1, 5, models.CoverageLiability, 400},
+		// 400 × 51 ÷ 54
+		{"liability one class up", nil, 2, 6, models.CoverageLiability, 377.78},
+		// 400 × 48 ÷ 54 + 150, Teilkasko does not depend on the SF class
+		{"partial", func(p *models.CarProfile) {
+			p.InsuranceCoverage = models.CoveragePartial
+		}, 3, 7, models.CoveragePartial, 505.56},
+		// (400 + 600) × 51 ÷ 54
+		{"comprehensive", func(p *models.CarProfile) {
+			p.InsuranceCoverage = models.CoverageComprehensive
+		}, 2, 6, models.CoverageComprehensive, 944.44},
+		{"comprehensive until switch", func(p *models.CarProfile) {
+			p.InsuranceCoverage = models.CoverageComprehensive
+			p.InsuranceSwitchYears = 2
+		}, 2, 6, models.CoverageComprehensive, 944.44},
+		// 400 × 48 ÷ 54 + 150
+		{"partial after switch", func(p *models.CarProfile) {
+			p.InsuranceCoverage = models.CoverageComprehensive
+			p.InsuranceSwitchYears = 2
+		}, 3, 7, models.CoveragePartial, 505.56},
+		// SF 35 is the highest class
+		{"highest class", func(p *models.CarProfile) {
+			p.InsuranceSFClass = 34
+		}, 4, 35, models.CoverageLiability, 400},
+		{"without insurance model", func(p *models.CarProfile) {
+			p.InsuranceModel = false
+			p.AnnualCarInsurance = 800
+		}, 3, 0, "", 800},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := base
+			if tt.modify != nil {
+				tt.modify(&profile)
+			}
+
+			got := insurancePremium(&profile, tt.year)
+			if got.Year != tt.year || got.SFClass != tt.wantSFClass || got.Coverage != tt.wantCover {
+				t.Errorf("insurancePremium() = year %d, SF %d, %s, want year %d, SF %d, %s",
+					got.Year, got.SFClass, got.Coverage, tt.year, tt.wantSFClass, tt.wantCover)
+			}
+			if math.Abs(got.Premium-tt.wantPremium) > 0.005 {
+				t.Errorf("Premium = %.2f, want %.2f", got.Premium, tt.wantPremium)
+			}
+		})
+	}
+}
+
+func TestInsurancePremiumsInTimeline(t *testing.T) {
+	// 400 € at SF 5, 377,78 € at SF 6
+	profile := &models.CarProfile{
+		AcquisitionType:           models.AcquisitionCash,
+		ExpectedYearsOfOwnership:  2,
+		InsuranceModel:            true,
+		InsuranceSFClass:          5,
+		InsuranceLiabilityPremium: 400,
+	}
+	calc := New().CalculateCosts(profile)
+
+	if len(calc.InsurancePremiums) != 2 {
+		t.Fatalf("InsurancePremiums has %d years, want 2", len(calc.InsurancePremiums))
+	}
+	for i, want := range []float64{400, 377.78} {
+		if got := calc.YearlyProjection[i].Insurance; math.Abs(got-want) > 0.005 {
+			t.Errorf("insurance in year %d = %.2f, want %.2f", i+1, got, want)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package calculator
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+	"math"
+)
+
+const (
+	defaultServiceIntervalMonths    = 12
+	defaultInspectionIntervalMonths = 24 // HU/AU every two years
+	defaultTireLifetimeKm           = 40000.0
+	defaultBrakeIntervalKm          = 60000.0
+)
+
+// calculateMaintenanceCosts spreads servicing, HU/AU, tyres, brakes and the
+// repair reserve into average monthly amounts.
+func (c *Calculator) calculateMaintenanceCosts(profile *models.CarProfile, calc *models.CostCalculation) {
+	calc.MonthlyServiceCost = c.calculateMonthlyServiceCost(profile)
+	calc.MonthlyInspectionCost = perInterval(profile.InspectionCost, float64(profile.InspectionIntervalMonths), defaultInspectionIntervalMonths)
+	calc.MonthlyTireCost = perKilometer(profile.TireCost, profile.TireLifetimeKm, defaultTireLifetimeKm) * profile.MonthlyKilometers
+	calc.MonthlyBrakeCost = perKilometer(profile.BrakeCost, profile.BrakeIntervalKm, defaultBrakeIntervalKm) * profile.MonthlyKilometers
+	calc.MonthlyRepairReserve = profile.AnnualRepairReserve / 12
+
+	calc.MonthlyMaintenanceCost = calc.MonthlyServiceCost + calc.MonthlyInspectionCost +
+		calc.MonthlyTireCost + calc.MonthlyBrakeCost + calc.MonthlyRepairReserve
+	calc.AnnualMaintenanceCost = calc.MonthlyMaintenanceCost * 12
+}
+
+// calculateMonthlyServiceCost returns the servicing costs per month. The
+// service is due after the time or the mileage interval, whichever comes first.
+func (c *Calculator) calculateMonthlyServiceCost(profile *models.CarProfile) float64 {
+	if profile.ServiceCost <= 0 {
+		return 0
+	}
+
+	intervalMonths := float64(profile.ServiceIntervalMonths)
+	if profile.ServiceIntervalKm > 0 && profile.MonthlyKilometers > 0 {
+		kmIntervalMonths := profile.ServiceIntervalKm / profile.MonthlyKilometers
+		if intervalMonths <= 0 {
+			intervalMonths = kmIntervalMonths
+		} else {
+			intervalMonths = math.Min(intervalMonths, kmIntervalMonths)
+		}
+	}
+
+	return perInterval(profile.ServiceCost, intervalMonths, defaultServiceIntervalMonths)
+}
+
+// perInterval returns the cost per month of an expense due every interval
+// months, using the default interval if none is entered.
+func perInterval(cost, intervalMonths, defaultMonths float64) float64 {
+	if cost <= 0 {
+		return 0
+	}
+	if intervalMonths <= 0 {
+		intervalMonths = defaultMonths
+	}
+	return cost / intervalMonths
+}
+
+// perKilometer returns the cost per km of an expense due every intervalKm
+// kilometers, using the default interval if none is entered.
+func perKilometer(cost, intervalKm, defaultKm float64) float64 {
+	if cost <= 0 {
+		return 0
+	}
+	if intervalKm <= 0 {
+		intervalKm = defaultKm
+	}
+	return cost / intervalKm
+}
+
+// scaleMaintenance scales the costs of servicing, HU/AU, tyres and brakes
+// and the repair reserve.
+func scaleMaintenance(profile *models.CarProfile, factor float64) {
+	profile.ServiceCost *= factor
+	profile.InspectionCost *= factor
+	profile.TireCost *= factor
+	profile.BrakeCost *= factor
+	profile.AnnualRepairReserve *= factor
+}
@@ -0,0 +1,175 @@
+package calculator
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// monteCarloProgressInterval is the number of iterations between progress
+// reports
+const monteCarloProgressInterval = 50
+
+var ErrMissingProfile = errors.New("an electric and a combustion profile are required")
+
+// Percentiles summarizes the simulated values of a cost.
+type Percentiles struct {
+	P10 float64 `json:"p10"`
+	P50 float64 `json:"p50"`
+	P90 float64 `json:"p90"`
+}
+
+// MonteCarloDistribution is the simulated cost range of one profile.
+type MonteCarloDistribution struct {
+	TCO       Percentiles `json:"tco"`
+	CostPerKm Percentiles `json:"cost_per_km"`
+}
+
+// MonteCarloResult is the outcome of the Monte Carlo simulation of an
+// electric and a combustion car.
+type MonteCarloResult struct {
+	ElectricProfile   *models.CarProfile     `json:"electric_profile"`
+	CombustionProfile *models.CarProfile     `json:"combustion_profile"`
+	Iterations        int                    `json:"iterations"`
+	Electric          MonteCarloDistribution `json:"electric"`
+	Combustion        MonteCarloDistribution `json:"combustion"`
+
+	// Share of the simulations in which the electric car has the lower
+	// total cost of ownership, in %
+	ElectricCheaperProbability float64 `json:"electric_cheaper_probability"`
+}
+
+// monteCarloSample holds the factors applied to the entered values in one
+// simulation.
+type monteCarloSample struct {
+	fuelPrice        float64
+	electricityPrice float64
+	kilometers       float64
+	resaleValue      float64
+	repairCosts      float64
+}
+
+// RunMonteCarlo simulates the total cost of ownership of an electric and a
+// combustion car with randomly drawn fuel and electricity prices, mileage,
+// resale value and repair costs. Both cars are calculated with the same
+// draws in each simulation, so the probability of the electric car being
+// cheaper reflects the inputs they share. Progress is reported every few
+// iterations; the simulation stops with the context's error when it is
+// cancelled. Both profiles are required.
+func (c *Calculator) RunMonteCarlo(ctx context.Context, electricProfile, combustionProfile *models.CarProfile,
+	settings models.MonteCarloSettings, seed int64, progress func(done, total int)) (*MonteCarloResult, error) {
+	if electricProfile == nil || combustionProfile == nil {
+		return nil, ErrMissingProfile
+	}
+
+	iterations := settings.Iterations
+	if iterations <= 0 {
+		iterations = models.DefaultMonteCarloSettings().Iterations
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	electricTCO := make([]float64, 0, iterations)
+	electricCostPerKm := make([]float64, 0, iterations)
+	combustionTCO := make([]float64, 0, iterations)
+	combustionCostPerKm := make([]float64, 0, iterations)
+	electricCheaper := 0
+
+	for i := 0; i < iterations; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		sample := monteCarloSample{
+			fuelPrice:        sampleFactor(rng, settings.FuelPrice),
+			electricityPrice: sampleFactor(rng, settings.ElectricityPrice),
+			kilometers:       sampleFactor(rng, settings.Kilometers),
+			resaleValue:      sampleFactor(rng, settings.ResaleValue),
+			repairCosts:      sampleFactor(rng, settings.RepairCosts),
+		}
+
+		tco, costPerKm := c.simulate(electricProfile, sample)
+		electricTCO = append(electricTCO, tco)
+		electricCostPerKm = append(electricCostPerKm, costPerKm)
+
+		combustion, combustionPerKm := c.simulate(combustionProfile, sample)
+		combustionTCO = append(combustionTCO, combustion)
+		combustionCostPerKm = append(combustionCostPerKm, combustionPerKm)
+
+		if tco < combustion {
+			electricCheaper++
+		}
+
+		if progress != nil && ((i+1)%monteCarloProgressInterval == 0 || i+1 == iterations) {
+			progress(i+1, iterations)
+		}
+	}
+
+	return &MonteCarloResult{
+		ElectricProfile:   electricProfile,
+		CombustionProfile: combustionProfile,
+		Iterations:        iterations,
+		Electric: MonteCarloDistribution{
+			TCO:       percentiles(electricTCO),
+			CostPerKm: percentiles(electricCostPerKm),
+		},
+		Combustion: MonteCarloDistribution{
+			TCO:       percentiles(combustionTCO),
+			CostPerKm: percentiles(combustionCostPerKm),
+		},
+		ElectricCheaperProbability: float64(electricCheaper) / float64(iterations) * 100,
+	}, nil
+}
+
+// simulate returns the total cost of ownership and the cost per kilometer
+// of the profile with the sampled inputs. The resale value is varied on the
+// calculated residual values, whichever depreciation model is used.
+func (c *Calculator) simulate(profile *models.CarProfile, sample monteCarloSample) (float64, float64) {
+	scaled := profile.Clone()
+	scaled.FuelPrice *= sample.fuelPrice
+	scaleElectricityPrice(scaled, sample.electricityPrice)
+	scaleKilometers(scaled, sample.kilometers)
+	scaleMaintenance(scaled, sample.repairCosts)
+
+	calc := c.calculateCosts(scaled, sample.resaleValue)
+	return calc.TotalCostOfOwnership, calc.CostPerKilometer
+}
+
+// sampleFactor draws a factor around 1 from the distribution of the input.
+// Negative factors are cut off at 0.
+func sampleFactor(rng *rand.Rand, input models.UncertainInput) float64 {
+	spread := input.Spread / 100
+
+	var factor float64
+	switch input.Distribution {
+	case models.DistributionUniform:
+		factor = 1 + spread*(2*rng.Float64()-1)
+	case models.DistributionTriangular:
+		// The difference of two uniform draws is triangular on [-1, 1]
+		factor = 1 + spread*(rng.Float64()-rng.Float64())
+	default:
+		factor = 1 + spread*rng.NormFloat64()
+	}
+	return math.Max(factor, 0)
+}
+
+// percentiles returns the 10th, 50th and 90th percentile of the values,
+// interpolating linearly between the sorted values.
+func percentiles(values []float64) Percentiles {
+	if len(values) == 0 {
+		return Percentiles{}
+	}
+
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+
+	at := func(p float64) float64 {
+		pos := p * float64(len(sorted)-1)
+		lower := int(math.Floor(pos))
+		upper := int(math.Ceil(pos))
+		return sorted[lower] + (sorted[upper]-sorted[lower])*(pos-float64(lower))
+	}
+	return Percentiles{P10: at(0.1), P50: at(0.5), P90: at(0.9)}
+}
@@ -0,0 +1,202 @@
+package calculator
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"auto-unterhaltsrechner/internal/models"
+)
+
+// newMonteCarloCalculator returns a calculator without discounting and CO2
+// price path, so that the costs can be added up by hand.
+func newMonteCarloCalculator() *Calculator {
+	c := New()
+	assumptions := models.DefaultAssumptions()
+	assumptions.CO2Prices = map[int]float64{}
+	assumptions.DiscountRate = 0
+	assumptions.IncludeCapitalCost = false
+	c.SetAssumptions(assumptions)
+	return c
+}
+
+func TestSimulate(t *testing.T) {
+	// 1000 km per month at 5 L/100 km and 2,00 €/L: 1200 € fuel per year.
+	// Service 300 €, half the HU/AU of 120 € and 240 € repair reserve: 600 €
+	profile := &models.CarProfile{
+		AcquisitionType:          models.AcquisitionCash,
+		FuelType:                 models.Super,
+		FuelConsumption:          5,
+		FuelPrice:                2,
+		MonthlyKilometers:        1000,
+		ExpectedYearsOfOwnership: 1,
+		ServiceCost:              300,
+		ServiceIntervalMonths:    12,
+		InspectionCost:           120,
+		InspectionIntervalMonths: 24,
+		AnnualRepairReserve:      240,
+	}
+	unchanged := monteCarloSample{fuelPrice: 1, electricityPrice: 1, kilometers: 1, resaleValue: 1, repairCosts: 1}
+
+	tests := []struct {
+		name          string
+		modify        func(*monteCarloSample)
+		wantTCO       float64
+		wantCostPerKm float64
+	}{
+		{"entered values", nil, 1800, 0.15},
+		// 1320 € fuel + 600 €
+		{"fuel price", func(s *monteCarloSample) { s.fuelPrice = 1.1 }, 1920, 0.16},
+		// 1200 € fuel + 2 × 600 € for servicing, HU/AU and repairs
+		{"repair costs", func(s *monteCarloSample) { s.repairCosts = 2 }, 2400, 0.2},
+		// 1440 € fuel + 600 € over 14400 km
+		{"kilometers", func(s *monteCarloSample) { s.kilometers = 1.2 }, 2040, 2040.0 / 14400},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sample := unchanged
+			if tt.modify != nil {
+				tt.modify(&sample)
+			}
+			tco, costPerKm := newMonteCarloCalculator().simulate(profile, sample)
+			if math.Abs(tco-tt.wantTCO) > 1e-6 {
+				t.Errorf("TCO = %.4f, want %.4f", tco, tt.wantTCO)
+			}
+			if math.Abs(costPerKm-tt.wantCostPerKm) > 1e-9 {
+				t.Errorf("cost per km = %.6f, want %.6f", costPerKm, tt.wantCostPerKm)
+			}
+		})
+	}
+}
+
+func TestCalculateCostsResidualFactor(t *testing.T) {
+	c := New()
+	assumptions := models.DefaultAssumptions()
+	assumptions.DiscountRate = 5
+	c.SetAssumptions(assumptions)
+
+	profile := &models.CarProfile{
+		AcquisitionType:          models.AcquisitionCash,
+		PurchasePrice:            30000,
+		DepreciationRate:         15,
+		ExpectedYearsOfOwnership: 3,
+	}
+	base := c.CalculateCosts(profile)
+	halved := c.calculateCosts(profile, 0.5)
+
+	for i, value := range halved.ResidualValues {
+		if math.Abs(value-base.ResidualValues[i]/2) > 1e-9 {
+			t.Errorf("ResidualValues[%d] = %.2f, want %.2f", i, value, base.ResidualValues[i]/2)
+		}
+	}
+
+	// Half the residual value less, discounted over 3 years at 5%
+	lost := base.ResidualValue / 2
+	tests := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"nominal cost", halved.NominalCostOfOwnership, base.NominalCostOfOwnership + lost},
+		{"present cost", halved.PresentCostOfOwnership, base.PresentCostOfOwnership + lost*math.Pow(1.05, -3)},
+		{"total depreciation", halved.TotalDepreciation, base.TotalDepreciation + lost},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if math.Abs(tt.got-tt.want) > 1e-6 {
+				t.Errorf("got %.4f, want %.4f", tt.got, tt.want)
+			}
+		})
+	}
+	if halved.EquivalentAnnualCost <= base.EquivalentAnnualCost {
+		t.Errorf("EquivalentAnnualCost = %.2f, want more than %.2f", halved.EquivalentAnnualCost, base.EquivalentAnnualCost)
+	}
+}
+
+func TestRunMonteCarlo(t *testing.T) {
+	electric := &models.CarProfile{
+		AcquisitionType:          models.AcquisitionCash,
+		ElectricConsumption:      20,
+		ElectricityPrice:         0.3,
+		PurchasePrice:            30000,
+		MonthlyKilometers:        1000,
+		ExpectedYearsOfOwnership: 5,
+		AnnualRepairReserve:      200,
+	}
+	combustion := &models.CarProfile{
+		AcquisitionType:          models.AcquisitionCash,
+		FuelType:                 models.Super,
+		FuelConsumption:          6,
+		FuelPrice:                1.8,
+		PurchasePrice:            25000,
+		MonthlyKilometers:        1000,
+		ExpectedYearsOfOwnership: 5,
+		AnnualRepairReserve:      400,
+	}
+	settings := models.DefaultMonteCarloSettings()
+	settings.Iterations = 200
+	c := newMonteCarloCalculator()
+
+	t.Run("fixed seed", func(t *testing.T) {
+		first, err := c.RunMonteCarlo(context.Background(), electric, combustion, settings, 42, nil)
+		if err != nil {
+			t.Fatalf("RunMonteCarlo() error = %v", err)
+		}
+		second, _ := c.RunMonteCarlo(context.Background(), electric, combustion, settings, 42, nil)
+		if !reflect.DeepEqual(first, second) {
+			t.Error("RunMonteCarlo() differs with the same seed")
+		}
+		for _, distribution := range []MonteCarloDistribution{first.Electric, first.Combustion} {
+			if !(distribution.TCO.P10 < distribution.TCO.P50 && distribution.TCO.P50 < distribution.TCO.P90) {
+				t.Errorf("TCO percentiles %+v not ascending", distribution.TCO)
+			}
+		}
+	})
+
+	t.Run("without spread", func(t *testing.T) {
+		fixed := models.MonteCarloSettings{Iterations: 10}
+		result, err := c.RunMonteCarlo(context.Background(), electric, combustion, fixed, 1, nil)
+		if err != nil {
+			t.Fatalf("RunMonteCarlo() error = %v", err)
+		}
+		tests := []struct {
+			name    string
+			got     Percentiles
+			profile *models.CarProfile
+		}{
+			{"electric", result.Electric.TCO, electric},
+			{"combustion", result.Combustion.TCO, combustion},
+		}
+		for _, tt := range tests {
+			want := c.CalculateCosts(tt.profile).TotalCostOfOwnership
+			if tt.got != (Percentiles{P10: want, P50: want, P90: want}) {
+				t.Errorf("%s TCO = %+v, want %.2f", tt.name, tt.got, want)
+			}
+		}
+	})
+
+	t.Run("progress and cancellation", func(t *testing.T) {
+		var reports []int
+		_, err := c.RunMonteCarlo(context.Background(), electric, combustion, settings, 1, func(done, total int) {
+			reports = append(reports, done)
+		})
+		if err != nil || len(reports) != 4 || reports[3] != 200 {
+			t.Errorf("progress = %v, error = %v, want 4 reports up to 200", reports, err)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := c.RunMonteCarlo(ctx, electric, combustion, settings, 1, nil); !errors.Is(err, context.Canceled) {
+			t.Errorf("RunMonteCarlo() error = %v, want %v", err, context.Canceled)
+		}
+	})
+
+	t.Run("missing profile", func(t *testing.T) {
+		if _, err := c.RunMonteCarlo(context.Background(), electric, nil, settings, 1, nil); !errors.Is(err, ErrMissingProfile) {
+			t.Errorf("RunMonteCarlo() error = %v, want %v", err, ErrMissingProfile)
+		}
+	})
+}
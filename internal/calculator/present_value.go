@@ -0,0 +1,43 @@
+package calculator
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+	"math"
+)
+
+// discountFactor returns the factor discounting a payment at the end of the
+// given month to the purchase at the annual rate in %.
+func discountFactor(rate float64, month int) float64 {
+	return math.Pow(1+rate/100, -float64(month)/12)
+}
+
+// calculatePresentValue discounts the upfront costs, the monthly payments of
+// the timeline and the residual value to the purchase. It derives the
+// equivalent annual cost over the ownership period and the interest forgone
+// on the money spent, compounded to the end of ownership.
+func (c *Calculator) calculatePresentValue(calc *models.CostCalculation) {
+	rate := c.assumptions.DiscountRate
+	months := len(calc.Timeline)
+
+	present := calc.UpfrontCosts
+	for _, month := range calc.Timeline {
+		present += month.Total * discountFactor(rate, month.Month)
+	}
+	present -= calc.ResidualValue * discountFactor(rate, months)
+	calc.PresentCostOfOwnership = present
+
+	if months == 0 {
+		return
+	}
+
+	years := float64(months) / 12
+	if rate > 0 {
+		annualRate := rate / 100
+		calc.EquivalentAnnualCost = present * annualRate / (1 - math.Pow(1+annualRate, -years))
+	} else {
+		calc.EquivalentAnnualCost = present / years
+	}
+
+	// The future value of all payments less their nominal sum
+	calc.CapitalCost = present/discountFactor(rate, months) - calc.NominalCostOfOwnership
+}
@@ -0,0 +1,93 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+
+	"auto-unterhaltsrechner/internal/models"
+)
+
+// timeline returns a timeline of the given length with the given totals at
+// the end of some months and nothing in the others.
+func timeline(months int, totals map[int]float64) []models.MonthlyCost {
+	entries := make([]models.MonthlyCost, months)
+	for i := range entries {
+		entries[i] = models.MonthlyCost{Month: i + 1, Total: totals[i+1]}
+	}
+	return entries
+}
+
+func TestCalculatePresentValue(t *testing.T) {
+	monthly100 := make(map[int]float64)
+	for month := 1; month <= 24; month++ {
+		monthly100[month] = 100
+	}
+
+	tests := []struct {
+		name        string
+		rate        float64
+		upfront     float64
+		timeline    []models.MonthlyCost
+		residual    float64
+		nominal     float64
+		wantPresent float64
+		wantEAC     float64
+		wantCapital float64
+	}{
+		// 10000 + 24 × 100 - 5000, spread over two years
+		{"zero rate", 0, 10000, timeline(24, monthly100), 5000, 7400, 7400, 3700, 0},
+		// 11000 after one year is worth 10000 today, the forgone interest
+		// equals the nominal gain
+		{"residual value discounted", 10, 10000, timeline(12, nil), 11000, -1000, 0, 0, 1000},
+		// 1100 ÷ 1,1 + 1210 ÷ 1,21; annuity 2000 × 0,1 ÷ (1 - 1,1^-2);
+		// 2000 × 1,21 - 2310
+		{"annual payments", 10, 0, timeline(24, map[int]float64{12: 1100, 24: 1210}), 0, 2310, 2000, 1152.38, 110},
+		{"empty timeline", 5, 3000, nil, 0, 3000, 3000, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New()
+			assumptions := models.DefaultAssumptions()
+			assumptions.DiscountRate = tt.rate
+			c.SetAssumptions(assumptions)
+
+			calc := &models.CostCalculation{
+				UpfrontCosts:           tt.upfront,
+				Timeline:               tt.timeline,
+				ResidualValue:          tt.residual,
+				NominalCostOfOwnership: tt.nominal,
+			}
+			c.calculatePresentValue(calc)
+
+			if math.Abs(calc.PresentCostOfOwnership-tt.wantPresent) > 0.01 {
+				t.Errorf("PresentCostOfOwnership = %.2f, want %.2f", calc.PresentCostOfOwnership, tt.wantPresent)
+			}
+			if math.Abs(calc.EquivalentAnnualCost-tt.wantEAC) > 0.01 {
+				t.Errorf("EquivalentAnnualCost = %.2f, want %.2f", calc.EquivalentAnnualCost, tt.wantEAC)
+			}
+			if math.Abs(calc.CapitalCost-tt.wantCapital) > 0.01 {
+				t.Errorf("CapitalCost = %.2f, want %.2f", calc.CapitalCost, tt.wantCapital)
+			}
+		})
+	}
+}
+
+func TestDiscountFactor(t *testing.T) {
+	tests := []struct {
+		rate  float64
+		month int
+		want  float64
+	}{
+		{0, 60, 1},
+		{10, 12, 1 / 1.1},
+		{10, 6, 1 / math.Sqrt(1.1)},
+		{5, 0, 1},
+	}
+
+	for _, tt := range tests {
+		if got := discountFactor(tt.rate, tt.month); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("discountFactor(%v, %d) = %v, want %v", tt.rate, tt.month, got, tt.want)
+		}
+	}
+}
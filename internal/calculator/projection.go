@@ -0,0 +1,130 @@
+package calculator
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+	"math"
+	"sort"
+)
+
+// escalate returns the value after the given number of years of price
+// escalation at rate % per year.
+func escalate(value, rate float64, years int) float64 {
+	return value * math.Pow(1+rate/100, float64(years))
+}
+
+// co2Price returns the CO2 price in €/t of the calendar year. Years before
+// the first entry use the first price, later years the latest price before.
+func (c *Calculator) co2Price(year int) float64 {
+	prices := c.assumptions.CO2Prices
+	if prices == nil {
+		prices = models.DefaultAssumptions().CO2Prices
+	}
+	if len(prices) == 0 {
+		return 0
+	}
+
+	years := make([]int, 0, len(prices))
+	for priceYear := range prices {
+		years = append(years, priceYear)
+	}
+	sort.Ints(years)
+
+	price := prices[years[0]]
+	for _, priceYear := range years {
+		if priceYear > year {
+			break
+		}
+		price = prices[priceYear]
+	}
+	return price
+}
+
+// co2Surcharge returns the change of the fuel price in €/L caused by the
+// CO2 price path in the given month, relative to the first month. The
+// entered fuel price already contains the current CO2 price.
+func (c *Calculator) co2Surcharge(profile *models.CarProfile, month int) float64 {
+	start := ownershipStart()
+	current := start.AddDate(0, month-1, 0)
+	priceChange := c.co2Price(current.Year()) - c.co2Price(start.Year())
+
+	// €/t × kg/L ÷ 1000
+	return priceChange * c.fuelCO2Factor(profile.FuelType) / 1000
+}
+
+// projectMonth returns the running costs of the given month of ownership
+// with the seasonal consumption, the battery aging, the annual price
+// escalation and the CO2 price path applied. Maintenance additionally rises
+// with every full year the car ages after the purchase, the entered costs
+// apply to the car at its age at purchase. The financing is not included,
+// Total covers the running costs only. The battery state, nil without a
+// traction battery, is advanced to the end of the month.
+func (c *Calculator) projectMonth(profile *models.CarProfile, calc *models.CostCalculation, month int, battery *batteryState) models.MonthlyCost {
+	years := (month - 1) / 12
+	assumptions := c.assumptions
+	fuelFactors, electricFactors := seasonalFactors(profile)
+	fuelFactor := fuelFactors[calendarMonth(month)-1]
+	electricFactor := electricFactors[calendarMonth(month)-1]
+
+	// An aged battery needs more grid energy per km, shifts the kilometers
+	// of a plug-in hybrid from electricity to fuel and is replaced below the
+	// threshold. gridEnergy is the share of the energy drawn in the first
+	// month.
+	fuelAmount, fuelCost, gridEnergy := calc.MonthlyFuelAmount, calc.MonthlyFuelCost, 1.0
+	var batteryCost float64
+	if battery != nil {
+		if battery.advance() {
+			batteryCost = profile.BatteryReplacementCost
+		}
+		if loss := c.electricShareLoss(profile, battery); loss > 0 && calc.ElectricShare > 0 {
+			fuelAmount += c.calculateMonthlyFuelAmount(profile, profile.MonthlyKilometers*loss)
+			fuelCost = fuelAmount * profile.FuelPrice
+			gridEnergy *= 1 - loss/(calc.ElectricShare/100)
+		}
+		gridEnergy *= battery.extraEnergyFactor()
+	}
+
+	entry := models.MonthlyCost{
+		Month: month,
+		Fuel: (escalate(fuelCost, assumptions.FuelPriceEscalation, years) +
+			fuelAmount*c.co2Surcharge(profile, month)) * fuelFactor,
+		Electricity: escalate(calc.MonthlyElectricityCost*gridEnergy, assumptions.ElectricityPriceEscalation, years) *
+			electricFactor,
+		Tax:       escalate(c.monthlyTax(profile, month), assumptions.TaxEscalation, years),
+		Insurance: escalate(insurancePremium(profile, years+1).Premium/12, assumptions.InsuranceEscalation, years),
+		Maintenance: escalate(escalate(calc.MonthlyMaintenanceCost, assumptions.MaintenanceEscalation, years),
+			assumptions.MaintenanceAging, years),
+		Custom:  calc.MonthlyCustomCost,
+		Battery: batteryCost,
+		Revenue: calc.MonthlyRevenue,
+		FuelCO2: fuelAmount * fuelFactor * c.fuelCO2Factor(profile.FuelType),
+		GridCO2: calc.MonthlyElectricityCO2 * gridEnergy * electricFactor,
+	}
+	entry.Total = entry.Fuel + entry.Electricity + entry.Tax + entry.Insurance + entry.Maintenance + entry.Custom +
+		entry.Battery - entry.Revenue
+	return entry
+}
+
+// calculateYearlyProjection sums the timeline per year of ownership.
+func calculateYearlyProjection(timeline []models.MonthlyCost) []models.YearlyCost {
+	var projection []models.YearlyCost
+	for _, month := range timeline {
+		year := (month.Month-1)/12 + 1
+		if len(projection) < year {
+			projection = append(projection, models.YearlyCost{Year: year})
+		}
+
+		entry := &projection[year-1]
+		entry.Fuel += month.Fuel
+		entry.Electricity += month.Electricity
+		entry.Tax += month.Tax
+		entry.Insurance += month.Insurance
+		entry.Maintenance += month.Maintenance
+		entry.Custom += month.Custom
+		entry.Battery += month.Battery
+		entry.Financing += month.Financing
+		entry.Revenue += month.Revenue
+		entry.Total += month.Total
+		entry.Cumulative = month.Cumulative
+	}
+	return projection
+}
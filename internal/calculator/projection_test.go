@@ -0,0 +1,42 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+
+	"auto-unterhaltsrechner/internal/models"
+)
+
+func TestProjectMonthMaintenanceAging(t *testing.T) {
+	tests := []struct {
+		name      string
+		ageYears  int
+		wantYear1 float64
+		wantYear3 float64
+	}{
+		// 700 € per year, 10% more per year of aging after the purchase
+		{"new car", 0, 700, 847},
+		{"six-year-old car", 6, 700, 847},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := &models.CarProfile{
+				MonthlyKilometers:        1000,
+				AnnualRepairReserve:      700,
+				ExpectedYearsOfOwnership: 3,
+			}
+			if tt.ageYears > 0 {
+				profile.FirstRegistration = ownershipStart().AddDate(-tt.ageYears, 0, 0)
+			}
+
+			calc := New().CalculateCosts(profile)
+			if got := calc.YearlyProjection[0].Maintenance; math.Abs(got-tt.wantYear1) > 0.01 {
+				t.Errorf("maintenance in year 1 = %.2f, want %.2f", got, tt.wantYear1)
+			}
+			if got := calc.YearlyProjection[2].Maintenance; math.Abs(got-tt.wantYear3) > 0.01 {
+				t.Errorf("maintenance in year 3 = %.2f, want %.2f", got, tt.wantYear3)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package calculator
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+	"time"
+)
+
+// Default consumption in % of the annual average per calendar month Jan-Dec.
+// Electric cars lose most in winter through heating and a cold battery,
+// plug-in hybrids additionally drive a larger share on fuel when the
+// electric range drops.
+var (
+	electricSeasonalFactors     = [12]float64{125, 122, 110, 100, 93, 90, 90, 90, 93, 100, 112, 122}
+	combustionSeasonalFactors   = [12]float64{107, 106, 103, 100, 98, 96, 95, 95, 97, 100, 103, 106}
+	plugInHybridSeasonalFactors = [12]float64{125, 120, 110, 100, 92, 88, 88, 88, 92, 100, 110, 120}
+)
+
+// seasonalFactors returns the fuel and electric consumption factors per
+// calendar month Jan-Dec, normalized to an average of 1 so the entered
+// consumption remains the annual average. Factors entered in the profile
+// apply to both fuel and electricity.
+func seasonalFactors(profile *models.CarProfile) (fuel, electric [12]float64) {
+	if len(profile.SeasonalFactors) == 12 {
+		var factors [12]float64
+		copy(factors[:], profile.SeasonalFactors)
+		factors = normalizeSeasonalFactors(factors)
+		return factors, factors
+	}
+
+	fuel = normalizeSeasonalFactors(combustionSeasonalFactors)
+	if isPlugInHybrid(profile) {
+		fuel = normalizeSeasonalFactors(plugInHybridSeasonalFactors)
+	}
+	return fuel, normalizeSeasonalFactors(electricSeasonalFactors)
+}
+
+// normalizeSeasonalFactors scales the factors to an average of 1. Factors
+// without a positive sum are replaced by a flat profile.
+func normalizeSeasonalFactors(factors [12]float64) [12]float64 {
+	var total float64
+	for _, factor := range factors {
+		total += factor
+	}
+
+	var normalized [12]float64
+	for i, factor := range factors {
+		normalized[i] = 1
+		if total > 0 {
+			normalized[i] = factor / total * 12
+		}
+	}
+	return normalized
+}
+
+// calendarMonth returns the calendar month of the given month of ownership.
+func calendarMonth(month int) time.Month {
+	return ownershipStart().AddDate(0, month-1, 0).Month()
+}
+
+// calculateSeasonalProfile returns the energy use and cost of the first 12
+// months of the timeline.
+func (c *Calculator) calculateSeasonalProfile(profile *models.CarProfile, calc *models.CostCalculation) []models.SeasonalMonth {
+	fuelFactors, electricFactors := seasonalFactors(profile)
+
+	var months []models.SeasonalMonth
+	for _, entry := range calc.Timeline {
+		if entry.Month > 12 {
+			break
+		}
+		month := calendarMonth(entry.Month)
+		months = append(months, models.SeasonalMonth{
+			Month:             month,
+			FuelAmount:        calc.MonthlyFuelAmount * fuelFactors[month-1],
+			ElectricityAmount: calc.MonthlyElectricityAmount * electricFactors[month-1],
+			EnergyCost:        entry.Fuel + entry.Electricity,
+		})
+	}
+	return months
+}
+
+func validateSeasonalFactors(profile *models.CarProfile) []string {
+	var errors []string
+
+	if len(profile.SeasonalFactors) > 0 && len(profile.SeasonalFactors) != 12 {
+		errors = append(errors, "Monatsfaktoren benötigen 12 Werte (Januar bis Dezember)")
+	}
+
+	for _, factor := range profile.SeasonalFactors {
+		if factor < 0 {
+			errors = append(errors, "Monatsfaktoren müssen >= 0 sein")
+			break
+		}
+	}
+
+	return errors
+}
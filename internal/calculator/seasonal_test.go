@@ -0,0 +1,99 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"auto-unterhaltsrechner/internal/models"
+)
+
+func TestSeasonalFactors(t *testing.T) {
+	tests := []struct {
+		name             string
+		profile          models.CarProfile
+		wantFuelJanuary  float64
+		wantElectricJuly float64
+	}{
+		// 107 ÷ 1206 × 12 and 90 ÷ 1247 × 12
+		{"combustion car", models.CarProfile{FuelConsumption: 6}, 1.06468, 0.86608},
+		// 125 ÷ 1233 × 12
+		{"plug-in hybrid", models.CarProfile{FuelConsumption: 5, ElectricConsumption: 20}, 1.21655, 0.86608},
+		// 2 ÷ 13 × 12 for both fuel and electricity
+		{"entered factors", models.CarProfile{SeasonalFactors: []float64{2, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}},
+			1.84615, 0.92308},
+		{"entered factors without sum", models.CarProfile{SeasonalFactors: make([]float64, 12)}, 1, 1},
+		{"incomplete factors use the defaults", models.CarProfile{FuelConsumption: 6,
+			SeasonalFactors: []float64{2, 1, 1}}, 1.06468, 0.86608},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fuel, electric := seasonalFactors(&tt.profile)
+			if got := fuel[time.January-1]; math.Abs(got-tt.wantFuelJanuary) > 1e-5 {
+				t.Errorf("fuel factor in January = %.5f, want %.5f", got, tt.wantFuelJanuary)
+			}
+			if got := electric[time.July-1]; math.Abs(got-tt.wantElectricJuly) > 1e-5 {
+				t.Errorf("electric factor in July = %.5f, want %.5f", got, tt.wantElectricJuly)
+			}
+
+			// The entered consumption remains the annual average
+			var fuelTotal, electricTotal float64
+			for i := range fuel {
+				fuelTotal += fuel[i]
+				electricTotal += electric[i]
+			}
+			if math.Abs(fuelTotal-12) > 1e-9 || math.Abs(electricTotal-12) > 1e-9 {
+				t.Errorf("factors add up to %.4f and %.4f, want 12", fuelTotal, electricTotal)
+			}
+		})
+	}
+}
+
+func TestSeasonalProfile(t *testing.T) {
+	// 200 kWh at 0,30 € per average month without losses: 60 €
+	profile := &models.CarProfile{
+		ElectricConsumption:      20,
+		ElectricityPrice:         0.3,
+		ChargingEfficiency:       100,
+		MonthlyKilometers:        1000,
+		ExpectedYearsOfOwnership: 1,
+	}
+	calc := New().CalculateCosts(profile)
+
+	if len(calc.SeasonalProfile) != 12 {
+		t.Fatalf("SeasonalProfile has %d months, want 12", len(calc.SeasonalProfile))
+	}
+
+	var january, july models.SeasonalMonth
+	var amount float64
+	for _, month := range calc.SeasonalProfile {
+		amount += month.ElectricityAmount
+		switch month.Month {
+		case time.January:
+			january = month
+		case time.July:
+			july = month
+		}
+	}
+
+	// 200 kWh × 125 ÷ 1247 × 12 in winter, × 90 ÷ 1247 × 12 in summer
+	tests := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"energy in January", january.ElectricityAmount, 240.58},
+		{"energy in July", july.ElectricityAmount, 173.22},
+		{"cost in January", january.EnergyCost, 72.17},
+		{"annual energy", amount, 2400},
+		{"annual cost", calc.AnnualElectricityCost, 720},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if math.Abs(tt.got-tt.want) > 0.005 {
+				t.Errorf("got %.2f, want %.2f", tt.got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package calculator
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+	"math"
+	"sort"
+)
+
+// sensitivityParameter is an input varied by the sensitivity analysis.
+// Inputs the calculation derives itself are varied on the calculator.
+type sensitivityParameter struct {
+	name            string
+	value           func(profile *models.CarProfile) float64
+	scale           func(profile *models.CarProfile, factor float64)
+	scaleCalculator func(c *Calculator, factor float64)
+}
+
+var sensitivityParameters = []sensitivityParameter{
+	{
+		name:  "Kraftstoffpreis",
+		value: func(p *models.CarProfile) float64 { return p.FuelPrice },
+		scale: func(p *models.CarProfile, f float64) { p.FuelPrice *= f },
+	},
+	{
+		name:  "Strompreis",
+		value: averageElectricityPrice,
+		scale: scaleElectricityPrice,
+	},
+	{
+		name:  "Kraftstoffverbrauch",
+		value: func(p *models.CarProfile) float64 { return p.FuelConsumption + p.FuelConsumptionByRoad.Total() },
+		scale: scaleFuelConsumption,
+	},
+	{
+		name:  "Stromverbrauch",
+		value: func(p *models.CarProfile) float64 { return p.ElectricConsumption + p.ElectricConsumptionByRoad.Total() },
+		scale: scaleElectricConsumption,
+	},
+	{
+		name:  "Monatliche Kilometer",
+		value: drivenKilometers,
+		scale: scaleKilometers,
+	},
+	{
+		name:  "Kaufpreis",
+		value: func(p *models.CarProfile) float64 { return p.PurchasePrice },
+		scale: func(p *models.CarProfile, f float64) { p.PurchasePrice *= f },
+	},
+	{
+		name:  "Versicherung",
+		value: func(p *models.CarProfile) float64 { return insurancePremium(p, 1).Premium },
+		scale: scaleInsurance,
+	},
+	{
+		name: "KFZ-Steuer",
+		value: func(p *models.CarProfile) float64 {
+			// The calculated tax may only start after the exemption of an
+			// electric car, a car without any tax is left out by its effect
+			if p.AutoCarTax {
+				return 1
+			}
+			return p.AnnualCarTax
+		},
+		scaleCalculator: func(c *Calculator, f float64) { c.carTaxFactor *= f },
+	},
+	{
+		name: "Wartung und Verschleiß",
+		value: func(p *models.CarProfile) float64 {
+			return p.ServiceCost + p.InspectionCost + p.TireCost + p.BrakeCost + p.AnnualRepairReserve
+		},
+		scale: scaleMaintenance,
+	},
+	{
+		name: "Weitere Kosten",
+		value: func(p *models.CarProfile) float64 {
+			var amount float64
+			for _, item := range p.CustomCosts {
+				amount += item.Amount
+			}
+			return amount
+		},
+		scale: func(p *models.CarProfile, f float64) {
+			for i := range p.CustomCosts {
+				p.CustomCosts[i].Amount *= f
+			}
+		},
+	},
+	{
+		name:  "Finanzierungsrate",
+		value: func(p *models.CarProfile) float64 { return p.FinancingRate },
+		scale: func(p *models.CarProfile, f float64) { p.FinancingRate *= f },
+	},
+	{
+		name:  "Effektivzins",
+		value: func(p *models.CarProfile) float64 { return p.InterestRate },
+		scale: func(p *models.CarProfile, f float64) { p.InterestRate *= f },
+	},
+	{
+		name:  "Wertverlust pro Jahr",
+		value: func(p *models.CarProfile) float64 { return p.DepreciationRate },
+		scale: func(p *models.CarProfile, f float64) { p.DepreciationRate *= f },
+	},
+	{
+		name:  "Wiederverkaufswert",
+		value: func(p *models.CarProfile) float64 { return p.ExpectedResaleValue },
+		scale: func(p *models.CarProfile, f float64) { p.ExpectedResaleValue *= f },
+	},
+	{
+		name:  "THG-Quote",
+		value: func(p *models.CarProfile) float64 { return p.AnnualTHGRevenue },
+		scale: func(p *models.CarProfile, f float64) { p.AnnualTHGRevenue *= f },
+	},
+}
+
+// scaleElectricityPrice scales the electricity price and the prices of all
+// charging sources.
+func scaleElectricityPrice(profile *models.CarProfile, factor float64) {
+	profile.ElectricityPrice *= factor
+	for i := range profile.ChargingSources {
+		profile.ChargingSources[i].Price *= factor
+	}
+}
+
+// SensitivityResult is the effect of lowering and raising one input.
+type SensitivityResult struct {
+	Parameter     string  `json:"parameter"`
+	LowTCO        float64 `json:"low_tco"` // input lowered by the variation
+	HighTCO       float64 `json:"high_tco"`
+	LowCostPerKm  float64 `json:"low_cost_per_km"`
+	HighCostPerKm float64 `json:"high_cost_per_km"`
+}
+
+// TCOSwing returns the spread of the total cost of ownership.
+func (r SensitivityResult) TCOSwing() float64 {
+	return math.Abs(r.HighTCO - r.LowTCO)
+}
+
+// CostPerKmSwing returns the spread of the cost per kilometer.
+func (r SensitivityResult) CostPerKmSwing() float64 {
+	return math.Abs(r.HighCostPerKm - r.LowCostPerKm)
+}
+
+// SensitivityAnalysis ranks the inputs of a profile by their effect on the
+// total cost of ownership.
+type SensitivityAnalysis struct {
+	Profile       *models.CarProfile  `json:"profile"`
+	Variation     float64             `json:"variation"` // ± %
+	BaseTCO       float64             `json:"base_tco"`
+	BaseCostPerKm float64             `json:"base_cost_per_km"`
+	Results       []SensitivityResult `json:"results"` // by descending TCO swing
+}
+
+// sensitivityThreshold hides inputs without a noticeable effect
+const sensitivityThreshold = 0.005
+
+// CalculateSensitivity varies each input of the profile by ± variation %
+// and ranks the inputs by their effect on the total cost of ownership.
+// Inputs that are not set or have no effect are left out.
+func (c *Calculator) CalculateSensitivity(profile *models.CarProfile, variation float64) *SensitivityAnalysis {
+	if profile == nil {
+		return nil
+	}
+
+	base := c.CalculateCosts(profile)
+	analysis := &SensitivityAnalysis{
+		Profile:       profile,
+		Variation:     variation,
+		BaseTCO:       base.TotalCostOfOwnership,
+		BaseCostPerKm: base.CostPerKilometer,
+	}
+
+	for _, parameter := range sensitivityParameters {
+		if parameter.value(profile) == 0 {
+			continue
+		}
+
+		low := c.calculateScaled(profile, parameter, 1-variation/100)
+		high := c.calculateScaled(profile, parameter, 1+variation/100)
+		result := SensitivityResult{
+			Parameter:     parameter.name,
+			LowTCO:        low.TotalCostOfOwnership,
+			HighTCO:       high.TotalCostOfOwnership,
+			LowCostPerKm:  low.CostPerKilometer,
+			HighCostPerKm: high.CostPerKilometer,
+		}
+		if result.TCOSwing() < sensitivityThreshold && result.CostPerKmSwing() < sensitivityThreshold {
+			continue
+		}
+		analysis.Results = append(analysis.Results, result)
+	}
+
+	sort.SliceStable(analysis.Results, func(i, j int) bool {
+		return analysis.Results[i].TCOSwing() > analysis.Results[j].TCOSwing()
+	})
+
+	return analysis
+}
+
+func (c *Calculator) calculateScaled(profile *models.CarProfile, parameter sensitivityParameter, factor float64) *models.CostCalculation {
+	scaled := profile.Clone()
+	if parameter.scale != nil {
+		parameter.scale(scaled, factor)
+	}
+
+	calculator := *c
+	if parameter.scaleCalculator != nil {
+		parameter.scaleCalculator(&calculator, factor)
+	}
+	return calculator.CalculateCosts(scaled)
+}
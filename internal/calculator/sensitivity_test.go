@@ -0,0 +1,72 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+
+	"auto-unterhaltsrechner/internal/models"
+)
+
+func TestSensitivityParameters(t *testing.T) {
+	base := models.CarProfile{
+		AcquisitionType:          models.AcquisitionCash,
+		FuelType:                 models.Super,
+		MonthlyKilometers:        1000,
+		ExpectedYearsOfOwnership: 2,
+	}
+
+	tests := []struct {
+		name      string
+		parameter string
+		modify    func(*models.CarProfile)
+		wantValue float64
+		wantSwing float64
+	}{
+		// 2 × 10% × 240 € × 2 years
+		{"entered car tax", "KFZ-Steuer", func(p *models.CarProfile) {
+			p.AnnualCarTax = 240
+		}, 240, 96},
+		// New petrol car: 15 × 2 € displacement + 20 × 2 € + 20 × 2,20 € CO2 = 114 € per year
+		{"calculated car tax", "KFZ-Steuer", func(p *models.CarProfile) {
+			p.AutoCarTax = true
+			p.EngineDisplacement = 1500
+			p.CO2Emissions = 135
+		}, 1, 45.6},
+		// 75% at 0,30 € and 25% at 0,60 €: 0,375 €/kWh for 200 kWh per month,
+		// 2 × 10% × 75 € × 24 months
+		{"charging mix price", "Strompreis", func(p *models.CarProfile) {
+			p.ElectricConsumption = 20
+			p.ChargingSources = []models.ChargingSource{
+				{Type: models.HomeWallbox, Price: 0.3, Share: 75, Efficiency: 100},
+				{Type: models.PublicFastCharging, Price: 0.6, Share: 25, Efficiency: 100},
+			}
+		}, 0.375, 360},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := base
+			tt.modify(&profile)
+
+			var parameter sensitivityParameter
+			for _, p := range sensitivityParameters {
+				if p.name == tt.parameter {
+					parameter = p
+				}
+			}
+			if got := parameter.value(&profile); math.Abs(got-tt.wantValue) > 1e-9 {
+				t.Errorf("value = %.4f, want %.4f", got, tt.wantValue)
+			}
+
+			c := newMonteCarloCalculator()
+			low := c.calculateScaled(&profile, parameter, 0.9)
+			high := c.calculateScaled(&profile, parameter, 1.1)
+			if got := high.TotalCostOfOwnership - low.TotalCostOfOwnership; math.Abs(got-tt.wantSwing) > 1e-6 {
+				t.Errorf("TCO swing = %.4f, want %.4f", got, tt.wantSwing)
+			}
+			if c.carTaxFactor != 1 {
+				t.Error("calculateScaled() changed the calculator")
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package calculator
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+	"math"
+	"time"
+)
+
+// Kfz-Steuer tariff according to the Kraftfahrzeugsteuergesetz (KraftStG)
+var (
+	// Cars first registered from 01.07.2009 are taxed by displacement and CO2
+	co2TaxStart = time.Date(2009, time.July, 1, 0, 0, 0, 0, time.UTC)
+
+	// Cars first registered from 01.01.2021 pay a progressive CO2 tax
+	progressiveCO2TaxStart = time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	// Electric cars first registered until 31.12.2030 are exempt for ten
+	// years, but not beyond 31.12.2035
+	electricExemptionRegistrationEnd = time.Date(2030, time.December, 31, 0, 0, 0, 0, time.UTC)
+	electricExemptionEnd             = time.Date(2035, time.December, 31, 0, 0, 0, 0, time.UTC)
+)
+
+const (
+	electricExemptionYears = 10
+
+	// Base tax per started 100 ccm from 01.07.2009
+	petrolRatePer100ccm = 2.00
+	dieselRatePer100ccm = 9.50
+
+	// Tax per started 100 ccm before 01.07.2009, Euro 3 or better
+	legacyPetrolRatePer100ccm = 6.75
+	legacyDieselRatePer100ccm = 15.44
+
+	// Tax per g/km above the threshold until 31.12.2020
+	linearCO2Rate = 2.00
+
+	// Electric cars after the exemption are taxed by weight, reduced by 50%
+	electricWeightReduction = 0.5
+)
+
+// co2Bracket is one step of the progressive CO2 tariff from 2021.
+type co2Bracket struct {
+	upTo float64 // g/km
+	rate float64 // € per g/km
+}
+
+var progressiveCO2Brackets = []co2Bracket{
+	{95, 0},
+	{115, 2.00},
+	{135, 2.20},
+	{155, 2.50},
+	{175, 2.90},
+	{195, 3.40},
+	{math.Inf(1), 4.00},
+}
+
+// weightBracket is one step of the weight tariff for electric cars.
+type weightBracket struct {
+	upTo float64 // kg
+	rate float64 // € per started 200 kg
+}
+
+var electricWeightBrackets = []weightBracket{
+	{2000, 11.25},
+	{3000, 12.02},
+	{3500, 12.78},
+}
+
+// isBatteryElectric reports whether the car has no combustion engine.
+func isBatteryElectric(profile *models.CarProfile) bool {
+	return profile.ElectricConsumption > 0 && profile.FuelConsumption <= 0
+}
+
+func isDiesel(profile *models.CarProfile) bool {
+	return profile.FuelType == models.Diesel || profile.FuelType == models.UltimateDiesel
+}
+
+// firstRegistration returns the first registration date of the profile,
+// assuming a new car if none is entered.
+func firstRegistration(profile *models.CarProfile) time.Time {
+	if profile.FirstRegistration.IsZero() {
+		return ownershipStart()
+	}
+	return profile.FirstRegistration
+}
+
+// ownershipStart returns the first month of the ownership period.
+func ownershipStart() time.Time {
+	now := time.Now()
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// CarTaxExemptUntil returns the end of the tax exemption of an electric
+// car, or the zero time if the car is not exempt.
+func CarTaxExemptUntil(profile *models.CarProfile) time.Time {
+	registration := firstRegistration(profile)
+	if !isBatteryElectric(profile) || registration.After(electricExemptionRegistrationEnd) {
+		return time.Time{}
+	}
+
+	end := registration.AddDate(electricExemptionYears, 0, 0)
+	if end.After(electricExemptionEnd) {
+		return electricExemptionEnd
+	}
+	return end
+}
+
+// CalculateCarTax returns the annual Kfz-Steuer due at the given date,
+// derived from first registration, fuel type, displacement, CO2 emissions
+// and, for electric cars, the permissible total weight.
+func (c *Calculator) CalculateCarTax(profile *models.CarProfile, date time.Time) float64 {
+	if isBatteryElectric(profile) {
+		if exemptUntil := CarTaxExemptUntil(profile); !exemptUntil.IsZero() && !date.After(exemptUntil) {
+			return 0
+		}
+		return calculateWeightTax(profile.VehicleWeight) * electricWeightReduction
+	}
+
+	registration := firstRegistration(profile)
+	started100ccm := math.Ceil(profile.EngineDisplacement / 100)
+
+	if registration.Before(co2TaxStart) {
+		if isDiesel(profile) {
+			return started100ccm * legacyDieselRatePer100ccm
+		}
+		return started100ccm * legacyPetrolRatePer100ccm
+	}
+
+	tax := started100ccm * petrolRatePer100ccm
+	if isDiesel(profile) {
+		tax = started100ccm * dieselRatePer100ccm
+	}
+
+	if !registration.Before(progressiveCO2TaxStart) {
+		return tax + progressiveCO2Tax(profile.CO2Emissions)
+	}
+	return tax + math.Max(profile.CO2Emissions-co2Threshold(registration), 0)*linearCO2Rate
+}
+
+// co2Threshold returns the tax-free CO2 emissions in g/km until 2020.
+func co2Threshold(registration time.Time) float64 {
+	switch {
+	case registration.Year() < 2012:
+		return 120
+	case registration.Year() < 2014:
+		return 110
+	default:
+		return 95
+	}
+}
+
+func progressiveCO2Tax(co2 float64) float64 {
+	var tax, lower float64
+	for _, bracket := range progressiveCO2Brackets {
+		if co2 <= lower {
+			break
+		}
+		tax += (math.Min(co2, bracket.upTo) - lower) * bracket.rate
+		lower = bracket.upTo
+	}
+	return tax
+}
+
+func calculateWeightTax(weight float64) float64 {
+	var tax, lower float64
+	for _, bracket := range electricWeightBrackets {
+		if weight <= lower {
+			break
+		}
+		tax += math.Ceil((math.Min(weight, bracket.upTo)-lower)/200) * bracket.rate
+		lower = bracket.upTo
+	}
+	return tax
+}
+
+// monthlyTax returns the Kfz-Steuer share of the given month of the
+// ownership period. Without automatic calculation the entered annual tax
+// is used.
+func (c *Calculator) monthlyTax(profile *models.CarProfile, month int) float64 {
+	annualTax := profile.AnnualCarTax
+	if profile.AutoCarTax {
+		annualTax = c.CalculateCarTax(profile, ownershipStart().AddDate(0, month-1, 0))
+	}
+	return annualTax * c.carTaxFactor / 12
+}
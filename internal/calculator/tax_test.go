@@ -0,0 +1,119 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"auto-unterhaltsrechner/internal/models"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestCalculateCarTaxCombustion(t *testing.T) {
+	tests := []struct {
+		name         string
+		fuelType     models.FuelType
+		registration time.Time
+		displacement float64
+		co2          float64
+		want         float64
+	}{
+		// 15 × 2,00 + 20 × 2,00 + 20 × 2,20 + 5 × 2,50
+		{"petrol from 2021", models.Super, date(2022, time.March, 1), 1498, 140, 126.50},
+		// 20 × 9,50 + 20 × 2,00 + 5 × 2,20
+		{"diesel from 2021", models.Diesel, date(2022, time.March, 1), 1968, 120, 241},
+		{"progressive tariff at threshold", models.Super, date(2021, time.January, 1), 999, 95, 20},
+		// 10 × 2,00 + 40 + 44 + 50 + 58 + 68 + 55 × 4,00
+		{"progressive tariff top bracket", models.Super, date(2023, time.July, 1), 999, 250, 500},
+		// 16 × 2,00 + (130 - 95) × 2,00
+		{"linear tariff from 2014", models.Super, date(2015, time.June, 1), 1598, 130, 102},
+		// 12 × 2,00 + (120 - 110) × 2,00
+		{"linear tariff 2012-2013", models.Super, date(2012, time.May, 1), 1200, 120, 44},
+		// 14 × 2,00 + (150 - 120) × 2,00
+		{"linear tariff until 2011", models.Super, date(2010, time.March, 1), 1390, 150, 88},
+		{"below linear threshold", models.Super, date(2016, time.March, 1), 999, 90, 20},
+		{"last day before progressive tariff", models.Super, date(2020, time.December, 31), 999, 115, 60},
+		// 16 × 6,75
+		{"petrol before July 2009", models.Super, date(2009, time.June, 30), 1595, 180, 108},
+		// 19 × 15,44
+		{"diesel before July 2009", models.Diesel, date(2008, time.January, 1), 1896, 160, 293.36},
+		{"first day of CO2 tariff", models.Diesel, date(2009, time.July, 1), 1896, 120, 180.50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := &models.CarProfile{
+				FuelType:           tt.fuelType,
+				FuelConsumption:    6,
+				FirstRegistration:  tt.registration,
+				EngineDisplacement: tt.displacement,
+				CO2Emissions:       tt.co2,
+			}
+			if got := New().CalculateCarTax(profile, date(2026, time.January, 1)); math.Abs(got-tt.want) > 0.005 {
+				t.Errorf("CalculateCarTax() = %.2f, want %.2f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalculateCarTaxElectric(t *testing.T) {
+	tests := []struct {
+		name         string
+		registration time.Time
+		weight       float64
+		date         time.Time
+		want         float64
+	}{
+		{"exempt", date(2022, time.May, 1), 2100, date(2026, time.January, 1), 0},
+		{"last day of exemption", date(2022, time.May, 1), 2100, date(2032, time.May, 1), 0},
+		// (10 × 11,25 + 1 × 12,02) × 50%
+		{"after exemption", date(2022, time.May, 1), 2100, date(2032, time.June, 1), 62.26},
+		// 9 × 11,25 × 50%
+		{"light car after exemption", date(2015, time.May, 1), 1800, date(2026, time.January, 1), 50.625},
+		{"exemption capped at 2035", date(2028, time.March, 1), 2100, date(2036, time.January, 1), 62.26},
+		{"registered after 2030", date(2031, time.January, 1), 2100, date(2031, time.February, 1), 62.26},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := &models.CarProfile{
+				ElectricConsumption: 18,
+				FirstRegistration:   tt.registration,
+				VehicleWeight:       tt.weight,
+			}
+			if got := New().CalculateCarTax(profile, tt.date); math.Abs(got-tt.want) > 0.005 {
+				t.Errorf("CalculateCarTax() = %.3f, want %.3f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCarTaxExemptUntil(t *testing.T) {
+	tests := []struct {
+		name         string
+		registration time.Time
+		want         time.Time
+	}{
+		{"ten years", date(2022, time.May, 1), date(2032, time.May, 1)},
+		{"capped at 31.12.2035", date(2028, time.March, 1), date(2035, time.December, 31)},
+		{"last registration day", date(2030, time.December, 31), date(2035, time.December, 31)},
+		{"registered after 2030", date(2031, time.January, 1), time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := &models.CarProfile{ElectricConsumption: 18, FirstRegistration: tt.registration}
+			if got := CarTaxExemptUntil(profile); !got.Equal(tt.want) {
+				t.Errorf("CarTaxExemptUntil() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	combustion := &models.CarProfile{FuelConsumption: 6, FirstRegistration: date(2022, time.May, 1)}
+	if got := CarTaxExemptUntil(combustion); !got.IsZero() {
+		t.Errorf("CarTaxExemptUntil() of a combustion car = %v, want zero time", got)
+	}
+}
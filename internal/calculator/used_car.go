@@ -0,0 +1,51 @@
+package calculator
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+	"math"
+)
+
+// vehicleAgeYears returns the age of the car at the start of the ownership
+// period, zero for a new car.
+func vehicleAgeYears(profile *models.CarProfile) float64 {
+	age := ownershipStart().Sub(firstRegistration(profile)).Hours() / 24 / 365.25
+	return math.Max(age, 0)
+}
+
+// odometerAt returns the odometer reading after the given number of months
+// of ownership. With an expected reading at sale the distance is spread
+// evenly over the ownership period, otherwise the monthly kilometers are
+// added to the reading at purchase.
+func odometerAt(profile *models.CarProfile, months int) float64 {
+	ownershipMonths := profile.ExpectedYearsOfOwnership * 12
+	if profile.OdometerAtSale > profile.OdometerAtPurchase && ownershipMonths > 0 {
+		return profile.OdometerAtPurchase + (profile.OdometerAtSale-profile.OdometerAtPurchase)*float64(months)/float64(ownershipMonths)
+	}
+	return profile.OdometerAtPurchase + profile.MonthlyKilometers*float64(months)
+}
+
+// calculateVehicleAge records the age of the car at purchase and at sale and
+// the odometer reading at sale.
+func (c *Calculator) calculateVehicleAge(profile *models.CarProfile, calc *models.CostCalculation) {
+	calc.VehicleAgeAtPurchase = vehicleAgeYears(profile)
+	calc.VehicleAgeAtSale = calc.VehicleAgeAtPurchase + float64(profile.ExpectedYearsOfOwnership)
+	calc.OdometerAtSale = odometerAt(profile, profile.ExpectedYearsOfOwnership*12)
+}
+
+func validateUsedCar(profile *models.CarProfile) []string {
+	var errors []string
+
+	if profile.OdometerAtPurchase < 0 || profile.OdometerAtSale < 0 {
+		errors = append(errors, "Kilometerstand muss >= 0 sein")
+	}
+
+	if profile.OdometerAtSale > 0 && profile.OdometerAtSale < profile.OdometerAtPurchase {
+		errors = append(errors, "Kilometerstand bei Verkauf muss >= Kilometerstand bei Kauf sein")
+	}
+
+	if profile.FirstRegistration.After(ownershipStart().AddDate(0, 1, 0)) {
+		errors = append(errors, "Erstzulassung darf nicht in der Zukunft liegen")
+	}
+
+	return errors
+}
@@ -16,43 +16,354 @@ type ElectricityType string
 
 const (
 	HomeSocket            ElectricityType = "home_socket"
-	PublicChargingStation ElectricityType = "public_charging_station"
+	PublicChargingStation ElectricityType = "public_charging_station" // AC
+	HomeWallbox           ElectricityType = "home_wallbox"
+	WorkplaceCharging     ElectricityType = "workplace"
+	PublicFastCharging    ElectricityType = "public_dc_fast"
 )
 
+// ChargingSource is one way of charging with its price and its share of
+// the charged energy.
+type ChargingSource struct {
+	Type       ElectricityType `json:"type"`
+	Price      float64         `json:"price"`      // €/kWh
+	Share      float64         `json:"share"`      // % of the charged energy
+	Efficiency float64         `json:"efficiency"` // %, grid to battery; default by type if empty
+}
+
+type AcquisitionType string
+
+const (
+	AcquisitionCash  AcquisitionType = "cash"
+	AcquisitionLoan  AcquisitionType = "loan"
+	AcquisitionLease AcquisitionType = "lease"
+)
+
+type DepreciationModelType string
+
+const (
+	DepreciationLinear           DepreciationModelType = "linear"
+	DepreciationDecliningBalance DepreciationModelType = "declining_balance"
+	DepreciationAgeMileage       DepreciationModelType = "age_mileage"
+	DepreciationResaleValue      DepreciationModelType = "resale_value"
+)
+
+// CompanyCarMethod is how the taxable benefit (geldwerter Vorteil) of a
+// company car is determined.
+type CompanyCarMethod string
+
+const (
+	CompanyCarFlatRate       CompanyCarMethod = "flat_rate" // 1%, 0.5% or 0.25% by powertrain
+	CompanyCarOnePercent     CompanyCarMethod = "one_percent"
+	CompanyCarHalfPercent    CompanyCarMethod = "half_percent"
+	CompanyCarQuarterPercent CompanyCarMethod = "quarter_percent"
+	CompanyCarLogbook        CompanyCarMethod = "logbook" // Fahrtenbuch
+)
+
+// InsuranceCoverage is the coverage tier of the car insurance.
+type InsuranceCoverage string
+
+const (
+	CoverageLiability     InsuranceCoverage = "liability"     // Haftpflicht
+	CoveragePartial       InsuranceCoverage = "partial"       // Teilkasko incl. Haftpflicht
+	CoverageComprehensive InsuranceCoverage = "comprehensive" // Vollkasko incl. Haftpflicht
+)
+
+type CostRecurrence string
+
+const (
+	RecurrenceOnce    CostRecurrence = "once"
+	RecurrenceMonthly CostRecurrence = "monthly"
+	RecurrenceAnnual  CostRecurrence = "annual"
+	RecurrencePerKm   CostRecurrence = "per_km"
+)
+
+// RoadTypeValues holds a value per road type, e.g. a consumption per
+// 100 km or a share of the distance in %.
+type RoadTypeValues struct {
+	City     float64 `json:"city"`
+	Rural    float64 `json:"rural"`
+	Motorway float64 `json:"motorway"`
+}
+
+// Total returns the sum over all road types.
+func (v RoadTypeValues) Total() float64 {
+	return v.City + v.Rural + v.Motorway
+}
+
+type TripFrequency string
+
+const (
+	TripWorkday TripFrequency = "workday"
+	TripWeekly  TripFrequency = "weekly"
+	TripMonthly TripFrequency = "monthly"
+	TripAnnual  TripFrequency = "annual"
+)
+
+// Trip is a recurring journey like the daily commute or the annual holiday.
+type Trip struct {
+	Name      string         `json:"name"`
+	Distance  float64        `json:"distance"` // km per trip incl. the way back
+	Frequency TripFrequency  `json:"frequency"`
+	Count     float64        `json:"count"` // trips per frequency period, 1 if empty
+	Mix       RoadTypeValues `json:"mix"`   // % of the distance per road type
+}
+
+// DistributionType is the probability distribution of an uncertain input.
+type DistributionType string
+
+const (
+	DistributionNormal     DistributionType = "normal"
+	DistributionUniform    DistributionType = "uniform"
+	DistributionTriangular DistributionType = "triangular"
+)
+
+// CustomCostItem is a user-defined cost like garage rent or a membership.
+type CustomCostItem struct {
+	Name       string         `json:"name"`
+	Amount     float64        `json:"amount"` // €, €/km for RecurrencePerKm
+	Recurrence CostRecurrence `json:"recurrence"`
+}
+
 type CarProfile struct {
-	ID                       string          `json:"id"`
-	Name                     string          `json:"name"`
-	FuelConsumption          float64         `json:"fuel_consumption"`     // L/100km
-	ElectricConsumption      float64         `json:"electric_consumption"` // kWh/100km
-	FuelPrice                float64         `json:"fuel_price"`           // €/L
-	ElectricityPrice         float64         `json:"electricity_price"`    // €/kWh
-	FuelType                 FuelType        `json:"fuel_type"`
-	ElectricityType          ElectricityType `json:"electricity_type"`
-	TankSize                 float64         `json:"tank_size"`    // L
-	BatterySize              float64         `json:"battery_size"` // kWh
-	MonthlyKilometers        float64         `json:"monthly_kilometers"`
-	AnnualCarTax             float64         `json:"annual_car_tax"`       // €
-	AnnualCarInsurance       float64         `json:"annual_car_insurance"` // €
-	FinancingRate            float64         `json:"financing_rate"`       // €/month
-	FinancingPeriod          int             `json:"financing_period"`     // months
-	PurchasePrice            float64         `json:"purchase_price"`       // €
-	ExpectedYearsOfOwnership int             `json:"expected_years_of_ownership"`
-	CreatedAt                time.Time       `json:"created_at"`
-	UpdatedAt                time.Time       `json:"updated_at"`
+	ID                        string                `json:"id"`
+	Name                      string                `json:"name"`
+	FuelConsumption           float64               `json:"fuel_consumption"`     // L/100km
+	ElectricConsumption       float64               `json:"electric_consumption"` // kWh/100km
+	FuelPrice                 float64               `json:"fuel_price"`           // €/L
+	ElectricityPrice          float64               `json:"electricity_price"`    // €/kWh
+	FuelType                  FuelType              `json:"fuel_type"`
+	ElectricityType           ElectricityType       `json:"electricity_type"`
+	ChargingSources           []ChargingSource      `json:"charging_sources"`           // overrides ElectricityPrice/-Type if set
+	ChargingEfficiency        float64               `json:"charging_efficiency"`        // %, without charging mix; default by type if empty
+	TankSize                  float64               `json:"tank_size"`                  // L
+	BatterySize               float64               `json:"battery_size"`               // kWh
+	BatteryAgingPerYear       float64               `json:"battery_aging_per_year"`     // % state of health lost per year, 1 if empty
+	BatteryAgingPer100k       float64               `json:"battery_aging_per_100k"`     // % state of health lost per 100.000 km, 4 if empty
+	BatteryReplacementCost    float64               `json:"battery_replacement_cost"`   // €, no replacement if empty
+	BatteryReplacementHealth  float64               `json:"battery_replacement_health"` // % state of health triggering the replacement, 70 if empty
+	MonthlyKilometers         float64               `json:"monthly_kilometers"`
+	ElectricShare             float64               `json:"electric_share"`              // % of km driven electrically (plug-in hybrids)
+	ElectricShareSet          bool                  `json:"electric_share_set"`          // ElectricShare is entered, so 0% is a valid share
+	DailyCommuteKm            float64               `json:"daily_commute_km"`            // used to derive ElectricShare
+	AnnualCarTax              float64               `json:"annual_car_tax"`              // €
+	AutoCarTax                bool                  `json:"auto_car_tax"`                // derive the tax from the fields below
+	FirstRegistration         time.Time             `json:"first_registration"`          // Erstzulassung, zero for a new car
+	EngineDisplacement        float64               `json:"engine_displacement"`         // ccm
+	CO2Emissions              float64               `json:"co2_emissions"`               // g/km WLTP
+	VehicleWeight             float64               `json:"vehicle_weight"`              // kg, zulässiges Gesamtgewicht
+	AnnualCarInsurance        float64               `json:"annual_car_insurance"`        // €, unless InsuranceModel is set
+	InsuranceModel            bool                  `json:"insurance_model"`             // premiums by SF class and coverage below
+	InsuranceCoverage         InsuranceCoverage     `json:"insurance_coverage"`          // empty behaves like liability
+	InsuranceSFClass          int                   `json:"insurance_sf_class"`          // Schadenfreiheitsklasse at purchase
+	InsuranceLiabilityPremium float64               `json:"insurance_liability_premium"` // € p.a. Haftpflicht at InsuranceSFClass
+	InsurancePartialPremium   float64               `json:"insurance_partial_premium"`   // € p.a. Teilkasko on top, independent of the SF class
+	InsuranceFullPremium      float64               `json:"insurance_full_premium"`      // € p.a. Vollkasko on top at InsuranceSFClass
+	InsuranceSwitchYears      int                   `json:"insurance_switch_years"`      // Vollkasko to Teilkasko after years, never if empty
+	AcquisitionType           AcquisitionType       `json:"acquisition_type"`            // empty behaves like loan
+	FinancingRate             float64               `json:"financing_rate"`              // €/month
+	FinancingPeriod           int                   `json:"financing_period"`            // months
+	DownPayment               float64               `json:"down_payment"`                // €
+	InterestRate              float64               `json:"interest_rate"`               // % p.a. effective
+	BalloonPayment            float64               `json:"balloon_payment"`             // €, final payment
+	LeaseSpecialPayment       float64               `json:"lease_special_payment"`       // €, Leasingsonderzahlung
+	LeaseAnnualKilometers     float64               `json:"lease_annual_kilometers"`
+	LeaseExcessKmCost         float64               `json:"lease_excess_km_cost"`    // €/km above contract
+	LeaseUnusedKmCredit       float64               `json:"lease_unused_km_credit"`  // €/km below contract
+	ServiceCost               float64               `json:"service_cost"`            // € per Inspektion
+	ServiceIntervalMonths     int                   `json:"service_interval_months"` // whichever comes first
+	ServiceIntervalKm         float64               `json:"service_interval_km"`
+	InspectionCost            float64               `json:"inspection_cost"`            // € per HU/AU
+	InspectionIntervalMonths  int                   `json:"inspection_interval_months"` // 24 if empty
+	TireCost                  float64               `json:"tire_cost"`                  // € per set of tyres incl. fitting
+	TireLifetimeKm            float64               `json:"tire_lifetime_km"`           // 40.000 if empty
+	BrakeCost                 float64               `json:"brake_cost"`                 // € per brake service
+	BrakeIntervalKm           float64               `json:"brake_interval_km"`          // 60.000 if empty
+	AnnualRepairReserve       float64               `json:"annual_repair_reserve"`      // €
+	CustomCosts               []CustomCostItem      `json:"custom_costs"`
+	AnnualTHGRevenue          float64               `json:"annual_thg_revenue"`   // €, THG-Quote sold per year
+	PurchaseIncentives        float64               `json:"purchase_incentives"`  // €, subsidies and dealer bonuses at purchase
+	PurchasePrice             float64               `json:"purchase_price"`       // €
+	OdometerAtPurchase        float64               `json:"odometer_at_purchase"` // km, 0 for a new car
+	OdometerAtSale            float64               `json:"odometer_at_sale"`     // km expected at sale, derived from MonthlyKilometers if empty
+	ExpectedYearsOfOwnership  int                   `json:"expected_years_of_ownership"`
+	DepreciationModel         DepreciationModelType `json:"depreciation_model"`    // empty behaves like linear
+	DepreciationRate          float64               `json:"depreciation_rate"`     // % p.a., declining balance
+	ExpectedResaleValue       float64               `json:"expected_resale_value"` // €, at the end of ownership
+	CompanyCar                bool                  `json:"company_car"`           // Dienstwagen, PurchasePrice is the gross list price
+	CompanyCarMethod          CompanyCarMethod      `json:"company_car_method"`    // empty behaves like flat rate
+	CommuteDistance           float64               `json:"commute_distance"`      // km one-way between home and work
+	PrivateUseShare           float64               `json:"private_use_share"`     // % of km driven privately incl. commute, Fahrtenbuch
+	PersonalTaxRate           float64               `json:"personal_tax_rate"`     // %, marginal rate incl. Soli and church tax
+	DrivingProfile            bool                  `json:"driving_profile"`       // derive consumption and km from the road types below
+	FuelConsumptionByRoad     RoadTypeValues        `json:"fuel_consumption_by_road"`
+	ElectricConsumptionByRoad RoadTypeValues        `json:"electric_consumption_by_road"`
+	DrivingMix                RoadTypeValues        `json:"driving_mix"` // % per road type, unless trips are entered
+	Trips                     []Trip                `json:"trips"`
+	SeasonalFactors           []float64             `json:"seasonal_factors"` // % consumption per calendar month Jan-Dec, default by powertrain if empty
+	CreatedAt                 time.Time             `json:"created_at"`
+	UpdatedAt                 time.Time             `json:"updated_at"`
 }
 
 type CostCalculation struct {
-	Profile                *CarProfile `json:"profile"`
-	MonthlyFuelCost        float64     `json:"monthly_fuel_cost"`
-	AnnualFuelCost         float64     `json:"annual_fuel_cost"`
-	MonthlyElectricityCost float64     `json:"monthly_electricity_cost"`
-	AnnualElectricityCost  float64     `json:"annual_electricity_cost"`
-	MonthlyRunningCosts    float64     `json:"monthly_running_costs"`
-	AnnualRunningCosts     float64     `json:"annual_running_costs"`
-	TotalDepreciation      float64     `json:"total_depreciation"`
-	AnnualDepreciation     float64     `json:"annual_depreciation"`
-	CostPerKilometer       float64     `json:"cost_per_kilometer"`
-	TotalCostOfOwnership   float64     `json:"total_cost_of_ownership"`
+	Profile                    *CarProfile     `json:"profile"`
+	MonthlyFuelCost            float64         `json:"monthly_fuel_cost"`
+	AnnualFuelCost             float64         `json:"annual_fuel_cost"`
+	MonthlyElectricityCost     float64         `json:"monthly_electricity_cost"`
+	AnnualElectricityCost      float64         `json:"annual_electricity_cost"`
+	MonthlyFuelAmount          float64         `json:"monthly_fuel_amount"`        // L
+	MonthlyElectricityAmount   float64         `json:"monthly_electricity_amount"` // kWh reaching the battery
+	ElectricShare              float64         `json:"electric_share"`             // % of km driven electrically
+	DrivingMix                 RoadTypeValues  `json:"driving_mix"`                // % of km per road type, with a driving profile
+	SeasonalProfile            []SeasonalMonth `json:"seasonal_profile"`           // first 12 months of ownership
+	BlendedElectricityPrice    float64         `json:"blended_electricity_price"`  // €/kWh drawn from the grid over all charging sources
+	MonthlyGridEnergy          float64         `json:"monthly_grid_energy"`        // kWh drawn from the grid incl. charging losses
+	ChargingBreakdown          []ChargingCost  `json:"charging_breakdown"`
+	MonthlyFuelCO2             float64         `json:"monthly_fuel_co2"`        // kg
+	MonthlyElectricityCO2      float64         `json:"monthly_electricity_co2"` // kg
+	MonthlyCO2                 float64         `json:"monthly_co2"`             // kg
+	AnnualCO2                  float64         `json:"annual_co2"`              // kg
+	LifetimeCO2                float64         `json:"lifetime_co2"`            // kg over the ownership period
+	MonthlyFinancingCost       float64         `json:"monthly_financing_cost"`  // average month of the first year
+	AnnualFinancingCost        float64         `json:"annual_financing_cost"`   // first year
+	FinancingMonths            int             `json:"financing_months"`
+	MonthlyRunningCosts        float64         `json:"monthly_running_costs"` // average month of the first year incl. financing
+	MonthlyCostsAfterFinancing float64         `json:"monthly_costs_after_financing"`
+	AnnualRunningCosts         float64         `json:"annual_running_costs"` // first year
+	MonthlyTaxCost             float64         `json:"monthly_tax_cost"`     // first month
+	AnnualTaxCost              float64         `json:"annual_tax_cost"`      // first year
+	TaxExemptUntil             time.Time       `json:"tax_exempt_until"`     // electric cars, zero if not exempt
+	MonthlyServiceCost         float64         `json:"monthly_service_cost"`
+	MonthlyInspectionCost      float64         `json:"monthly_inspection_cost"`
+	MonthlyTireCost            float64         `json:"monthly_tire_cost"`
+	MonthlyBrakeCost           float64         `json:"monthly_brake_cost"`
+	MonthlyRepairReserve       float64         `json:"monthly_repair_reserve"`
+	MonthlyMaintenanceCost     float64         `json:"monthly_maintenance_cost"` // sum of the above
+	AnnualMaintenanceCost      float64         `json:"annual_maintenance_cost"`
+	MonthlyCustomCost          float64         `json:"monthly_custom_cost"`   // recurring custom cost items
+	OneOffCustomCost           float64         `json:"one_off_custom_cost"`   // paid at purchase
+	CustomCostBreakdown        []CustomCost    `json:"custom_cost_breakdown"` // per item
+	MonthlyRevenue             float64         `json:"monthly_revenue"`       // THG-Quote, credited
+	AnnualRevenue              float64         `json:"annual_revenue"`        // first year
+	PurchaseIncentives         float64         `json:"purchase_incentives"`   // credited at purchase
+	TotalDepreciation          float64         `json:"total_depreciation"`
+	AnnualDepreciation         float64         `json:"annual_depreciation"`
+	ResidualValue              float64         `json:"residual_value"`          // at the end of ownership
+	ResidualValues             []float64       `json:"residual_values"`         // at the end of each year of ownership
+	VehicleAgeAtPurchase       float64         `json:"vehicle_age_at_purchase"` // years since the first registration
+	VehicleAgeAtSale           float64         `json:"vehicle_age_at_sale"`     // years
+	OdometerAtSale             float64         `json:"odometer_at_sale"`        // km, entered or derived
+	UpfrontCosts               float64         `json:"upfront_costs"`           // paid at purchase
+	CostPerKilometer           float64         `json:"cost_per_kilometer"`
+	TotalCostOfOwnership       float64         `json:"total_cost_of_ownership"`        // incl. CapitalCost if enabled
+	NominalCostOfOwnership     float64         `json:"nominal_cost_of_ownership"`      // sum of nominal payments less residual value
+	PresentCostOfOwnership     float64         `json:"present_cost_of_ownership"`      // discounted to the purchase
+	EquivalentAnnualCost       float64         `json:"equivalent_annual_cost"`         // annuity of the present cost
+	CapitalCost                float64         `json:"capital_cost"`                   // interest forgone at the discount rate
+	CompanyCarRate             float64         `json:"company_car_rate"`               // % of the list price per month, flat rate
+	CompanyCarListPriceBenefit float64         `json:"company_car_list_price_benefit"` // monthly, flat rate
+	CompanyCarCommuteBenefit   float64         `json:"company_car_commute_benefit"`    // monthly, 0.03% surcharge
+	CompanyCarFlatRateBenefit  float64         `json:"company_car_flat_rate_benefit"`  // monthly, sum of the above
+	CompanyCarLogbookBenefit   float64         `json:"company_car_logbook_benefit"`    // monthly, Fahrtenbuch
+	CompanyCarBenefit          float64         `json:"company_car_benefit"`            // monthly, selected method
+	MonthlyCompanyCarCost      float64         `json:"monthly_company_car_cost"`       // net salary reduction
+	AnnualCompanyCarCost       float64         `json:"annual_company_car_cost"`
+	CompanyCarTotalCost        float64         `json:"company_car_total_cost"` // net salary reduction over the ownership period
+	Timeline                   []MonthlyCost   `json:"timeline"`
+	YearlyProjection           []YearlyCost    `json:"yearly_projection"`          // Timeline per year of ownership
+	AnnualInsuranceCost        float64         `json:"annual_insurance_cost"`      // first year
+	InsurancePremiums          []InsuranceYear `json:"insurance_premiums"`         // per year of ownership
+	BatteryHealth              []float64       `json:"battery_health"`             // % state of health at the end of each year of ownership
+	BatteryReplacementMonths   []int           `json:"battery_replacement_months"` // months of ownership with a battery replacement
+
+	// Loan details, only set when the financing is modelled as a loan
+	LoanAmount           float64       `json:"loan_amount"`
+	TotalInterest        float64       `json:"total_interest"`
+	AmortizationSchedule []LoanPayment `json:"amortization_schedule"`
+
+	// Lease details, only set for leased cars
+	LeaseExcessKilometers float64 `json:"lease_excess_kilometers"` // negative if fewer km were driven
+	LeaseSettlement       float64 `json:"lease_settlement"`        // negative for a refund
+}
+
+// InsuranceYear is the insurance premium of one year of ownership,
+// before the price escalation.
+type InsuranceYear struct {
+	Year     int               `json:"year"` // 1-based
+	SFClass  int               `json:"sf_class"`
+	Coverage InsuranceCoverage `json:"coverage"`
+	Premium  float64           `json:"premium"` // € p.a.
+}
+
+// SeasonalMonth is the energy used and paid in one calendar month of the
+// first year of ownership.
+type SeasonalMonth struct {
+	Month             time.Month `json:"month"`
+	FuelAmount        float64    `json:"fuel_amount"`        // L
+	ElectricityAmount float64    `json:"electricity_amount"` // kWh reaching the battery
+	EnergyCost        float64    `json:"energy_cost"`        // fuel and electricity
+}
+
+// ChargingCost is the monthly energy and cost of one charging source.
+type ChargingCost struct {
+	Type       ElectricityType `json:"type"`
+	Share      float64         `json:"share"`       // % of the charged energy, normalized
+	Price      float64         `json:"price"`       // €/kWh
+	Energy     float64         `json:"energy"`      // kWh per month reaching the battery
+	Efficiency float64         `json:"efficiency"`  // %
+	GridEnergy float64         `json:"grid_energy"` // kWh per month drawn from the grid
+	Monthly    float64         `json:"monthly"`
+}
+
+// CustomCost is the calculated amount of one custom cost item.
+type CustomCost struct {
+	Name       string         `json:"name"`
+	Recurrence CostRecurrence `json:"recurrence"`
+	Monthly    float64        `json:"monthly"`
+	OneOff     float64        `json:"one_off"`
+}
+
+// LoanPayment is one month of a loan amortization schedule.
+type LoanPayment struct {
+	Month     int     `json:"month"` // 1-based
+	Payment   float64 `json:"payment"`
+	Interest  float64 `json:"interest"`
+	Principal float64 `json:"principal"`
+	Balance   float64 `json:"balance"` // remaining after this payment
+}
+
+// MonthlyCost is one month of the running cost timeline over the ownership period.
+type MonthlyCost struct {
+	Month       int     `json:"month"` // 1-based
+	Fuel        float64 `json:"fuel"`
+	Electricity float64 `json:"electricity"`
+	Tax         float64 `json:"tax"`
+	Insurance   float64 `json:"insurance"`
+	Maintenance float64 `json:"maintenance"`
+	Custom      float64 `json:"custom"`
+	Battery     float64 `json:"battery"` // battery replacement
+	Revenue     float64 `json:"revenue"` // credited, reduces Total
+	Financing   float64 `json:"financing"`
+	Total       float64 `json:"total"`
+	Cumulative  float64 `json:"cumulative"` // running costs up to and including this month
+	FuelCO2     float64 `json:"fuel_co2"`   // kg
+	GridCO2     float64 `json:"grid_co2"`   // kg, from the energy drawn from the grid
+}
+
+// YearlyCost sums the timeline per year of ownership.
+type YearlyCost struct {
+	Year        int     `json:"year"` // 1-based
+	Fuel        float64 `json:"fuel"`
+	Electricity float64 `json:"electricity"`
+	Tax         float64 `json:"tax"`
+	Insurance   float64 `json:"insurance"`
+	Maintenance float64 `json:"maintenance"`
+	Custom      float64 `json:"custom"`
+	Battery     float64 `json:"battery"`
+	Financing   float64 `json:"financing"`
+	Revenue     float64 `json:"revenue"`
+	Total       float64 `json:"total"`
+	Cumulative  float64 `json:"cumulative"`
 }
 
 type ComparisonResult struct {
@@ -68,6 +379,101 @@ type AppSettings struct {
 	DefaultElectricityPrice float64 `json:"default_electricity_price"`
 	LastProfilesDir         string  `json:"last_profiles_dir"`
 	LastExportDir           string  `json:"last_export_dir"`
+
+	Assumptions Assumptions        `json:"assumptions"`
+	MonteCarlo  MonteCarloSettings `json:"monte_carlo"`
+}
+
+// UncertainInput describes how an input varies around its entered value.
+// Spread is the standard deviation of a normal distribution or the half
+// width of a uniform or triangular distribution, in % of the value.
+type UncertainInput struct {
+	Distribution DistributionType `json:"distribution"`
+	Spread       float64          `json:"spread"`
+}
+
+// MonteCarloSettings are the distributions of the uncertain inputs of the
+// Monte Carlo simulation.
+type MonteCarloSettings struct {
+	Iterations       int            `json:"iterations"`
+	FuelPrice        UncertainInput `json:"fuel_price"`
+	ElectricityPrice UncertainInput `json:"electricity_price"`
+	Kilometers       UncertainInput `json:"kilometers"`
+	ResaleValue      UncertainInput `json:"resale_value"`
+	RepairCosts      UncertainInput `json:"repair_costs"`
+}
+
+// DefaultMonteCarloSettings returns moderate uncertainties for energy
+// prices and resale value and skewed ranges for mileage and repairs.
+func DefaultMonteCarloSettings() MonteCarloSettings {
+	return MonteCarloSettings{
+		Iterations:       5000,
+		FuelPrice:        UncertainInput{Distribution: DistributionNormal, Spread: 15},
+		ElectricityPrice: UncertainInput{Distribution: DistributionNormal, Spread: 15},
+		Kilometers:       UncertainInput{Distribution: DistributionTriangular, Spread: 20},
+		ResaleValue:      UncertainInput{Distribution: DistributionNormal, Spread: 15},
+		RepairCosts:      UncertainInput{Distribution: DistributionUniform, Spread: 50},
+	}
+}
+
+// Assumptions are calculation parameters shared by all profiles. Fields
+// missing from the saved settings fall back to DefaultAssumptions, saved
+// maps replace the default maps.
+type Assumptions struct {
+	FuelCO2Factors map[FuelType]float64        `json:"fuel_co2_factors"` // kg CO2 per L
+	GridCO2Factors map[ElectricityType]float64 `json:"grid_co2_factors"` // g CO2 per kWh
+
+	// Annual price escalation in % per year of ownership
+	FuelPriceEscalation        float64 `json:"fuel_price_escalation"`
+	ElectricityPriceEscalation float64 `json:"electricity_price_escalation"`
+	InsuranceEscalation        float64 `json:"insurance_escalation"`
+	TaxEscalation              float64 `json:"tax_escalation"`
+	MaintenanceEscalation      float64 `json:"maintenance_escalation"`
+
+	// Real increase of maintenance and repair costs in % per year as the
+	// car ages, on top of the price escalation
+	MaintenanceAging float64 `json:"maintenance_aging"`
+
+	// Discount rate in % per year for present values. With
+	// IncludeCapitalCost the interest forgone on the money spent is added
+	// to the total cost of ownership.
+	DiscountRate       float64 `json:"discount_rate"`
+	IncludeCapitalCost bool    `json:"include_capital_cost"`
+
+	// CO2 price for fossil fuels in € per tonne by calendar year (BEHG and
+	// EU ETS 2). Years without an entry use the previous year's price.
+	CO2Prices map[int]float64 `json:"co2_prices"`
+}
+
+// DefaultAssumptions returns tank-to-wheel CO2 factors for fuels and the
+// German electricity mix for all charging types.
+func DefaultAssumptions() Assumptions {
+	return Assumptions{
+		MaintenanceAging: 10,
+		FuelCO2Factors: map[FuelType]float64{
+			Diesel:         2.65,
+			UltimateDiesel: 2.65,
+			Super:          2.37,
+			SuperPlus:      2.37,
+			Ultimate:       2.37,
+		},
+		GridCO2Factors: map[ElectricityType]float64{
+			HomeSocket:            363,
+			HomeWallbox:           363,
+			WorkplaceCharging:     363,
+			PublicChargingStation: 363,
+			PublicFastCharging:    363,
+		},
+		CO2Prices: map[int]float64{
+			2024: 45,
+			2025: 55,
+			2026: 65,
+			2027: 75,
+			2028: 90,
+			2029: 105,
+			2030: 120,
+		},
+	}
 }
 
 func GetFuelTypes() []FuelType {
@@ -75,7 +481,35 @@ func GetFuelTypes() []FuelType {
 }
 
 func GetElectricityTypes() []ElectricityType {
-	return []ElectricityType{HomeSocket, PublicChargingStation}
+	return []ElectricityType{HomeSocket, HomeWallbox, WorkplaceCharging, PublicChargingStation, PublicFastCharging}
+}
+
+func GetAcquisitionTypes() []AcquisitionType {
+	return []AcquisitionType{AcquisitionCash, AcquisitionLoan, AcquisitionLease}
+}
+
+func GetDepreciationModels() []DepreciationModelType {
+	return []DepreciationModelType{DepreciationLinear, DepreciationDecliningBalance, DepreciationAgeMileage, DepreciationResaleValue}
+}
+
+func GetInsuranceCoverages() []InsuranceCoverage {
+	return []InsuranceCoverage{CoverageLiability, CoveragePartial, CoverageComprehensive}
+}
+
+func GetCompanyCarMethods() []CompanyCarMethod {
+	return []CompanyCarMethod{CompanyCarFlatRate, CompanyCarOnePercent, CompanyCarHalfPercent, CompanyCarQuarterPercent, CompanyCarLogbook}
+}
+
+func GetCostRecurrences() []CostRecurrence {
+	return []CostRecurrence{RecurrenceOnce, RecurrenceMonthly, RecurrenceAnnual, RecurrencePerKm}
+}
+
+func GetTripFrequencies() []TripFrequency {
+	return []TripFrequency{TripWorkday, TripWeekly, TripMonthly, TripAnnual}
+}
+
+func GetDistributionTypes() []DistributionType {
+	return []DistributionType{DistributionNormal, DistributionUniform, DistributionTriangular}
 }
 
 func NewCarProfile() *CarProfile {
@@ -87,6 +521,17 @@ func NewCarProfile() *CarProfile {
 	}
 }
 
+// Clone returns a deep copy of the profile that can be modified without
+// affecting the original, e.g. for what-if calculations.
+func (p *CarProfile) Clone() *CarProfile {
+	clone := *p
+	clone.ChargingSources = append([]ChargingSource(nil), p.ChargingSources...)
+	clone.CustomCosts = append([]CustomCostItem(nil), p.CustomCosts...)
+	clone.Trips = append([]Trip(nil), p.Trips...)
+	clone.SeasonalFactors = append([]float64(nil), p.SeasonalFactors...)
+	return &clone
+}
+
 func generateID() string {
 	return time.Now().Format("20060102150405")
 }
@@ -146,17 +146,41 @@ func (s *Storage) LoadSettings() (*models.AppSettings, error) {
 				Theme:                   "light",
 				DefaultFuelPrice:        1.65,
 				DefaultElectricityPrice: 0.35,
+				Assumptions:             models.DefaultAssumptions(),
+				MonteCarlo:              models.DefaultMonteCarloSettings(),
 			}, nil
 		}
 		return nil, fmt.Errorf("failed to read settings file: %w", err)
 	}
 
-	var settings models.AppSettings
+	// Start from the defaults so that settings saved by an older version
+	// keep the default for assumptions added since. Unmarshalling into a
+	// map would merge the entries, so the default maps are only filled in
+	// when the saved settings have none; removed entries stay removed.
+	defaults := models.DefaultAssumptions()
+	assumptions := defaults
+	assumptions.FuelCO2Factors = nil
+	assumptions.GridCO2Factors = nil
+	assumptions.CO2Prices = nil
+	settings := models.AppSettings{
+		Assumptions: assumptions,
+		MonteCarlo:  models.DefaultMonteCarloSettings(),
+	}
 	err = json.Unmarshal(data, &settings)
 	if err != nil {
 		return nil, fmt.Errorf("failed to unmarshal settings: %w", err)
 	}
 
+	if settings.Assumptions.FuelCO2Factors == nil {
+		settings.Assumptions.FuelCO2Factors = defaults.FuelCO2Factors
+	}
+	if settings.Assumptions.GridCO2Factors == nil {
+		settings.Assumptions.GridCO2Factors = defaults.GridCO2Factors
+	}
+	if settings.Assumptions.CO2Prices == nil {
+		settings.Assumptions.CO2Prices = defaults.CO2Prices
+	}
+
 	return &settings, nil
 }
 
@@ -0,0 +1,58 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadSettingsFillsMissingAssumptions(t *testing.T) {
+	tests := []struct {
+		name                 string
+		settings             string
+		wantMaintenanceAging float64
+		wantFuelEscalation   float64
+		wantCO2Price2030     float64
+		wantCO2Prices        int // number of CO2 price years
+	}{
+		{"settings without assumptions", `{"theme": "dark"}`, 10, 0, 120, 7},
+		{"assumptions saved before maintenance aging",
+			`{"assumptions": {"fuel_price_escalation": 2, "co2_prices": {"2030": 150}}}`, 10, 2, 150, 1},
+		{"maintenance aging switched off", `{"assumptions": {"maintenance_aging": 0}}`, 0, 0, 120, 7},
+		// Years removed from the price path do not come back
+		{"removed CO2 price years", `{"assumptions": {"co2_prices": {"2024": 45, "2030": 120}}}`, 10, 0, 120, 2},
+		{"all CO2 prices removed", `{"assumptions": {"co2_prices": {}}}`, 10, 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Storage{dataDir: t.TempDir()}
+			if err := os.WriteFile(filepath.Join(s.dataDir, "settings.json"), []byte(tt.settings), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			settings, err := s.LoadSettings()
+			if err != nil {
+				t.Fatalf("LoadSettings() error = %v", err)
+			}
+			if got := settings.Assumptions.MaintenanceAging; got != tt.wantMaintenanceAging {
+				t.Errorf("MaintenanceAging = %v, want %v", got, tt.wantMaintenanceAging)
+			}
+			if got := settings.Assumptions.FuelPriceEscalation; got != tt.wantFuelEscalation {
+				t.Errorf("FuelPriceEscalation = %v, want %v", got, tt.wantFuelEscalation)
+			}
+			if got := settings.Assumptions.CO2Prices[2030]; got != tt.wantCO2Price2030 {
+				t.Errorf("CO2Prices[2030] = %v, want %v", got, tt.wantCO2Price2030)
+			}
+			if got := len(settings.Assumptions.CO2Prices); got != tt.wantCO2Prices {
+				t.Errorf("CO2Prices has %d years, want %d", got, tt.wantCO2Prices)
+			}
+			if len(settings.Assumptions.FuelCO2Factors) == 0 || len(settings.Assumptions.GridCO2Factors) == 0 {
+				t.Error("CO2 factors are empty, want the defaults")
+			}
+			if settings.MonteCarlo.Iterations == 0 {
+				t.Error("MonteCarlo.Iterations = 0, want the default")
+			}
+		})
+	}
+}
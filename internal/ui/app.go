@@ -32,23 +32,104 @@ type App struct {
 	resultsView   *fyne.Container
 	mainContent   *container.Split
 
+	// Sensitivity analysis tab
+	sensitivityChart     *tornadoChart
+	sensitivityDetails   *fyne.Container
+	sensitivityVariation float64
+	sensitivityMetric    string
+
+	// Holding period tab
+	holdingPeriodChart      *lineChart
+	holdingPeriodLabel      *widget.Label
+	holdingPeriodHoverLabel *widget.Label
+	holdingPeriodButton     *widget.Button
+	holdingPeriodOptimal    int
+
 	// Input widgets
-	nameEntry                *widget.Entry
-	fuelConsumptionEntry     *widget.Entry
-	electricConsumptionEntry *widget.Entry
-	fuelPriceEntry           *widget.Entry
-	electricityPriceEntry    *widget.Entry
-	fuelTypeSelect           *widget.Select
-	electricityTypeSelect    *widget.Select
-	tankSizeEntry            *widget.Entry
-	batterySizeEntry         *widget.Entry
-	monthlyKmEntry           *widget.Entry
-	annualTaxEntry           *widget.Entry
-	annualInsuranceEntry     *widget.Entry
-	financingRateEntry       *widget.Entry
-	financingPeriodEntry     *widget.Entry
-	purchasePriceEntry       *widget.Entry
-	ownershipYearsEntry      *widget.Entry
+	nameEntry                     *widget.Entry
+	fuelConsumptionEntry          *widget.Entry
+	electricConsumptionEntry      *widget.Entry
+	fuelPriceEntry                *widget.Entry
+	electricityPriceEntry         *widget.Entry
+	fuelTypeSelect                *widget.Select
+	electricityTypeSelect         *widget.Select
+	chargingEfficiencyEntry       *widget.Entry
+	tankSizeEntry                 *widget.Entry
+	batterySizeEntry              *widget.Entry
+	batteryAgingPerYearEntry      *widget.Entry
+	batteryAgingPer100kEntry      *widget.Entry
+	batteryReplacementCostEntry   *widget.Entry
+	batteryReplacementHealthEntry *widget.Entry
+	monthlyKmEntry                *widget.Entry
+	electricShareEntry            *widget.Entry
+	dailyCommuteEntry             *widget.Entry
+	seasonalFactorsEntry          *widget.Entry
+	annualTaxEntry                *widget.Entry
+	autoCarTaxCheck               *widget.Check
+	firstRegistrationEntry        *widget.Entry
+	engineDisplacementEntry       *widget.Entry
+	co2EmissionsEntry             *widget.Entry
+	vehicleWeightEntry            *widget.Entry
+	annualInsuranceEntry          *widget.Entry
+	insuranceModelCheck           *widget.Check
+	insuranceCoverageSelect       *widget.Select
+	insuranceForm                 *widget.Form
+	insuranceSFClassEntry         *widget.Entry
+	insuranceLiabilityEntry       *widget.Entry
+	insurancePartialEntry         *widget.Entry
+	insuranceFullEntry            *widget.Entry
+	insuranceSwitchEntry          *widget.Entry
+	acquisitionTypeSelect         *widget.Select
+	financingRateEntry            *widget.Entry
+	financingPeriodEntry          *widget.Entry
+	downPaymentEntry              *widget.Entry
+	interestRateEntry             *widget.Entry
+	balloonPaymentEntry           *widget.Entry
+	leaseSpecialPaymentEntry      *widget.Entry
+	leaseAnnualKmEntry            *widget.Entry
+	leaseExcessKmCostEntry        *widget.Entry
+	leaseUnusedKmCreditEntry      *widget.Entry
+	serviceCostEntry              *widget.Entry
+	serviceIntervalMonthsEntry    *widget.Entry
+	serviceIntervalKmEntry        *widget.Entry
+	inspectionCostEntry           *widget.Entry
+	inspectionIntervalEntry       *widget.Entry
+	tireCostEntry                 *widget.Entry
+	tireLifetimeKmEntry           *widget.Entry
+	brakeCostEntry                *widget.Entry
+	brakeIntervalKmEntry          *widget.Entry
+	repairReserveEntry            *widget.Entry
+	annualTHGRevenueEntry         *widget.Entry
+	purchaseIncentivesEntry       *widget.Entry
+	depreciationModelSelect       *widget.Select
+	depreciationRateEntry         *widget.Entry
+	expectedResaleValueEntry      *widget.Entry
+	companyCarCheck               *widget.Check
+	companyCarMethodSelect        *widget.Select
+	companyCarForm                *widget.Form
+	commuteDistanceEntry          *widget.Entry
+	privateUseShareEntry          *widget.Entry
+	personalTaxRateEntry          *widget.Entry
+	fuelCityEntry                 *widget.Entry
+	fuelRuralEntry                *widget.Entry
+	fuelMotorwayEntry             *widget.Entry
+	electricCityEntry             *widget.Entry
+	electricRuralEntry            *widget.Entry
+	electricMotorwayEntry         *widget.Entry
+	cityShareEntry                *widget.Entry
+	ruralShareEntry               *widget.Entry
+	motorwayShareEntry            *widget.Entry
+	customCostsBox                *fyne.Container
+	drivingProfileCheck           *widget.Check
+	drivingProfileContent         *fyne.Container
+	tripsBox                      *fyne.Container
+	chargingSourcesBox            *fyne.Container
+	loanForm                      *widget.Form
+	leaseForm                     *widget.Form
+	purchasePriceEntry            *widget.Entry
+	odometerAtPurchaseEntry       *widget.Entry
+	odometerAtSaleEntry           *widget.Entry
+	ownershipYearsEntry           *widget.Entry
 }
 
 func NewApp() *App {
@@ -78,8 +159,11 @@ func NewApp() *App {
 			Language:                "de",
 			DefaultFuelPrice:        1.65,
 			DefaultElectricityPrice: 0.35,
+			Assumptions:             models.DefaultAssumptions(),
+			MonteCarlo:              models.DefaultMonteCarloSettings(),
 		}
 	}
+	calc.SetAssumptions(settings.Assumptions)
 
 	appInstance := &App{
 		fyneApp:    fyneApp,
@@ -162,6 +246,18 @@ func (a *App) createToolbar() *widget.Toolbar {
 		a.showComparisonDialog()
 	}))
 
+	toolbar.Append(widget.NewToolbarAction(theme.HistoryIcon(), func() {
+		a.showBreakEvenDialog()
+	}))
+
+	toolbar.Append(widget.NewToolbarAction(theme.GridIcon(), func() {
+		a.showMonteCarloDialog()
+	}))
+
+	toolbar.Append(widget.NewToolbarAction(theme.SearchIcon(), func() {
+		a.showGoalSeekDialog()
+	}))
+
 	toolbar.Append(widget.NewToolbarSeparator())
 
 	toolbar.Append(widget.NewToolbarAction(theme.SettingsIcon(), func() {
@@ -182,8 +278,13 @@ func (a *App) createMainContent() *container.Split {
 	leftPanel := container.NewBorder(nil, nil, nil, nil,
 		container.NewScroll(a.inputForm))
 
-	rightPanel := container.NewBorder(nil, nil, nil, nil,
-		container.NewScroll(a.resultsView))
+	resultsTabs := container.NewAppTabs(
+		container.NewTabItem("Ergebnisse", container.NewScroll(a.resultsView)),
+		container.NewTabItem("Sensitivität", container.NewScroll(a.createSensitivityView())),
+		container.NewTabItem("Haltedauer", a.createHoldingPeriodView()),
+	)
+
+	rightPanel := container.NewBorder(nil, nil, nil, nil, resultsTabs)
 
 	split := container.NewHSplit(leftPanel, rightPanel)
 	split.SetOffset(0.5)
@@ -0,0 +1,164 @@
+package ui
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2/widget"
+)
+
+// createAssumptionItems returns the settings form items for the shared
+// calculation assumptions and a function writing the entered values back.
+func (a *App) createAssumptionItems(assumptions *models.Assumptions) ([]*widget.FormItem, func()) {
+	translations := a.getCurrentTranslations()
+	defaults := models.DefaultAssumptions()
+
+	var items []*widget.FormItem
+	var apply []func()
+
+	fuelFactors := make(map[models.FuelType]float64)
+	for _, fuelType := range models.GetFuelTypes() {
+		fuelType := fuelType
+		factor, ok := assumptions.FuelCO2Factors[fuelType]
+		if !ok {
+			factor = defaults.FuelCO2Factors[fuelType]
+		}
+		fuelFactors[fuelType] = factor
+
+		entry := widget.NewEntry()
+		entry.SetText(FormatGermanNumber(factor, 2))
+		items = append(items, widget.NewFormItem(
+			fmt.Sprintf(translations.SettingsFuelCO2, a.translateFuelType(string(fuelType))), entry))
+		apply = append(apply, func() {
+			if val, err := ParseGermanNumber(entry.Text); err == nil && val >= 0 {
+				fuelFactors[fuelType] = val
+			}
+		})
+	}
+
+	gridFactors := make(map[models.ElectricityType]float64)
+	for _, electricityType := range models.GetElectricityTypes() {
+		electricityType := electricityType
+		factor, ok := assumptions.GridCO2Factors[electricityType]
+		if !ok {
+			factor = defaults.GridCO2Factors[electricityType]
+		}
+		gridFactors[electricityType] = factor
+
+		entry := widget.NewEntry()
+		entry.SetText(FormatGermanNumber(factor, 0))
+		items = append(items, widget.NewFormItem(
+			fmt.Sprintf(translations.SettingsGridCO2, a.translateElectricityType(string(electricityType))), entry))
+		apply = append(apply, func() {
+			if val, err := ParseGermanNumber(entry.Text); err == nil && val >= 0 {
+				gridFactors[electricityType] = val
+			}
+		})
+	}
+
+	escalations := []struct {
+		label string
+		value *float64
+	}{
+		{translations.SettingsFuelEscalation, &assumptions.FuelPriceEscalation},
+		{translations.SettingsElecEscalation, &assumptions.ElectricityPriceEscalation},
+		{translations.SettingsInsuranceEscalation, &assumptions.InsuranceEscalation},
+		{translations.SettingsTaxEscalation, &assumptions.TaxEscalation},
+		{translations.SettingsMaintenanceEscalation, &assumptions.MaintenanceEscalation},
+		{translations.SettingsMaintenanceAging, &assumptions.MaintenanceAging},
+	}
+	for _, escalation := range escalations {
+		value := escalation.value
+
+		entry := widget.NewEntry()
+		entry.SetText(FormatGermanNumber(*value, 1))
+		items = append(items, widget.NewFormItem(escalation.label, entry))
+		apply = append(apply, func() {
+			if val, err := ParseGermanNumber(entry.Text); err == nil {
+				*value = val
+			}
+		})
+	}
+
+	co2Prices := assumptions.CO2Prices
+	if co2Prices == nil {
+		co2Prices = defaults.CO2Prices
+	}
+	co2PricesEntry := widget.NewEntry()
+	co2PricesEntry.SetText(formatCO2Prices(co2Prices))
+	items = append(items, widget.NewFormItem(translations.SettingsCO2Prices, co2PricesEntry))
+	apply = append(apply, func() {
+		if prices, err := parseCO2Prices(co2PricesEntry.Text); err == nil {
+			co2Prices = prices
+		}
+	})
+
+	discountRateEntry := widget.NewEntry()
+	discountRateEntry.SetText(FormatGermanNumber(assumptions.DiscountRate, 1))
+	items = append(items, widget.NewFormItem(translations.SettingsDiscountRate, discountRateEntry))
+	apply = append(apply, func() {
+		if val, err := ParseGermanNumber(discountRateEntry.Text); err == nil && val > -100 {
+			assumptions.DiscountRate = val
+		}
+	})
+
+	capitalCostCheck := widget.NewCheck(translations.SettingsIncludeCapitalCost, nil)
+	capitalCostCheck.SetChecked(assumptions.IncludeCapitalCost)
+	items = append(items, widget.NewFormItem("", capitalCostCheck))
+	apply = append(apply, func() {
+		assumptions.IncludeCapitalCost = capitalCostCheck.Checked
+	})
+
+	return items, func() {
+		for _, fn := range apply {
+			fn()
+		}
+		assumptions.FuelCO2Factors = fuelFactors
+		assumptions.GridCO2Factors = gridFactors
+		assumptions.CO2Prices = co2Prices
+	}
+}
+
+// formatCO2Prices formats a CO2 price path as "2025: 55; 2026: 65".
+func formatCO2Prices(prices map[int]float64) string {
+	years := make([]int, 0, len(prices))
+	for year := range prices {
+		years = append(years, year)
+	}
+	sort.Ints(years)
+
+	parts := make([]string, 0, len(years))
+	for _, year := range years {
+		parts = append(parts, fmt.Sprintf("%d: %s", year, FormatGermanNumber(prices[year], 0)))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// parseCO2Prices parses a CO2 price path formatted by formatCO2Prices.
+func parseCO2Prices(text string) (map[int]float64, error) {
+	prices := make(map[int]float64)
+	for _, part := range strings.Split(text, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		yearText, priceText, found := strings.Cut(part, ":")
+		if !found {
+			return nil, fmt.Errorf("invalid CO2 price %q", part)
+		}
+		year, err := strconv.Atoi(strings.TrimSpace(yearText))
+		if err != nil {
+			return nil, fmt.Errorf("invalid year %q: %w", yearText, err)
+		}
+		price, err := ParseGermanNumber(strings.TrimSpace(priceText))
+		if err != nil {
+			return nil, fmt.Errorf("invalid CO2 price %q: %w", priceText, err)
+		}
+		prices[year] = price
+	}
+	return prices, nil
+}
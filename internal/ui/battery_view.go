@@ -0,0 +1,48 @@
+package ui
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+	"fmt"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/widget"
+)
+
+// batteryHealthRows describes the state of health, the remaining range and
+// the charging sessions at the end of each year of ownership and the
+// battery replacements as label and value pairs.
+func batteryHealthRows(calculation *models.CostCalculation, formatCurrency func(float64) string) [][]string {
+	profile := calculation.Profile
+	var rows [][]string
+	for i, health := range calculation.BatteryHealth {
+		capacity := profile.BatterySize * health / 100
+		value := fmt.Sprintf("%s (Reichweite %s", FormatPercentage(health),
+			FormatKilometers(capacity/profile.ElectricConsumption*100))
+		if capacity > 0 && calculation.MonthlyElectricityAmount > 0 {
+			value += ", " + FormatGermanNumber(calculation.MonthlyElectricityAmount/capacity, 1) + " Ladevorgänge pro Monat"
+		}
+		rows = append(rows, []string{fmt.Sprintf("Batteriezustand nach Jahr %d", i+1), value + ")"})
+	}
+
+	for _, month := range calculation.BatteryReplacementMonths {
+		rows = append(rows, []string{fmt.Sprintf("Batterietausch in Monat %d (Jahr %d)", month, (month-1)/12+1),
+			formatCurrency(calculation.Timeline[month-1].Battery)})
+	}
+	return rows
+}
+
+// addBatteryHealthLabels adds the battery aging to the range card.
+func (a *App) addBatteryHealthLabels(content *fyne.Container, calculation *models.CostCalculation) {
+	for _, row := range batteryHealthRows(calculation, FormatCurrency) {
+		content.Add(widget.NewLabel(row[0] + ": " + row[1]))
+	}
+}
+
+// firstYearBatteryCost returns the battery replacement costs of the first
+// year of ownership, which are part of the annual running costs.
+func firstYearBatteryCost(calculation *models.CostCalculation) float64 {
+	if len(calculation.YearlyProjection) == 0 {
+		return 0
+	}
+	return calculation.YearlyProjection[0].Battery
+}
@@ -0,0 +1,127 @@
+package ui
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+	"fmt"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+)
+
+func (a *App) showBreakEvenDialog() {
+	profiles, err := a.storage.ListProfiles()
+	if err != nil {
+		dialog.ShowError(err, a.window)
+		return
+	}
+
+	if len(profiles) < 2 {
+		dialog.ShowInformation("Nicht genügend Profile", "Für eine Break-Even-Analyse werden mindestens 2 Profile benötigt.", a.window)
+		return
+	}
+
+	var profileList []string
+	profileMap := make(map[string]*models.CarProfile)
+	for _, profile := range profiles {
+		displayName := fmt.Sprintf("%s (%s)", profile.Name, profile.ID)
+		profileList = append(profileList, displayName)
+		profileMap[displayName] = profile
+	}
+
+	breakEvenWindow := a.fyneApp.NewWindow("Break-Even-Analyse")
+	breakEvenWindow.Resize(fyne.NewSize(1000, 750))
+
+	chart := newLineChart()
+	chart.FormatX = func(v float64) string { return FormatGermanNumber(v, 0) }
+	chart.FormatY = func(v float64) string { return FormatGermanNumber(v, 0) + " €" }
+
+	breakEvenLabel := widget.NewLabel("")
+	breakEvenKmLabel := widget.NewLabel("")
+	savingsLabel := widget.NewLabel("")
+	hoverLabel := widget.NewLabel("Bewegen Sie die Maus über das Diagramm, um die Werte eines Monats anzuzeigen.")
+
+	electricSelect := widget.NewSelect(profileList, nil)
+	combustionSelect := widget.NewSelect(profileList, nil)
+
+	update := func() {
+		electricProfile := profileMap[electricSelect.Selected]
+		combustionProfile := profileMap[combustionSelect.Selected]
+		if electricProfile == nil || combustionProfile == nil {
+			return
+		}
+
+		analysis := a.calculator.CalculateBreakEven(electricProfile, combustionProfile)
+		if analysis == nil {
+			return
+		}
+
+		months := make([]float64, len(analysis.ElectricCumulativeCosts))
+		for i := range months {
+			months[i] = float64(i)
+		}
+
+		marker := math.NaN()
+		if analysis.BreakEvenMonths >= 0 {
+			marker = math.Max(float64(analysis.BreakEvenMonths), 0)
+			breakEvenLabel.SetText(fmt.Sprintf("Break-Even nach: %d Monaten (%s Jahren)",
+				analysis.BreakEvenMonths, FormatGermanNumber(float64(analysis.BreakEvenMonths)/12, 1)))
+			breakEvenKmLabel.SetText("Break-Even-Kilometer: " + FormatKilometers(analysis.BreakEvenKilometers))
+		} else {
+			breakEvenLabel.SetText("Break-Even nach: kein Break-Even, das Elektrofahrzeug amortisiert sich nicht")
+			breakEvenKmLabel.SetText("Break-Even-Kilometer: -")
+		}
+		savingsLabel.SetText("Gesamtersparnis über die Besitzdauer: " + FormatCurrency(analysis.TotalSavings))
+
+		chart.OnHover = func(index int) {
+			hoverLabel.SetText(fmt.Sprintf("Monat %d: %s %s, %s %s", index,
+				electricProfile.Name, FormatCurrency(analysis.ElectricCumulativeCosts[index]),
+				combustionProfile.Name, FormatCurrency(analysis.CombustionCumulativeCosts[index])))
+		}
+		chart.SetData(months, []chartSeries{
+			{Name: electricProfile.Name, Color: chartColorElectric, Values: analysis.ElectricCumulativeCosts},
+			{Name: combustionProfile.Name, Color: chartColorCombustion, Values: analysis.CombustionCumulativeCosts},
+		}, marker)
+	}
+
+	electricSelect.OnChanged = func(string) { update() }
+	combustionSelect.OnChanged = func(string) { update() }
+
+	// Preselect the first electric and the first combustion profile
+	for _, name := range profileList {
+		profile := profileMap[name]
+		if electricSelect.Selected == "" && profile.ElectricConsumption > 0 && profile.FuelConsumption == 0 {
+			electricSelect.SetSelected(name)
+		}
+		if combustionSelect.Selected == "" && profile.FuelConsumption > 0 {
+			combustionSelect.SetSelected(name)
+		}
+	}
+
+	selectionForm := widget.NewForm(
+		widget.NewFormItem("Elektrofahrzeug", electricSelect),
+		widget.NewFormItem("Verbrenner", combustionSelect),
+	)
+
+	summary := container.NewVBox(
+		breakEvenLabel,
+		breakEvenKmLabel,
+		savingsLabel,
+	)
+
+	content := container.NewBorder(
+		container.NewVBox(
+			widget.NewCard("Fahrzeuge", "", selectionForm),
+			widget.NewCard("Ergebnis", "", summary),
+		),
+		hoverLabel,
+		nil,
+		nil,
+		widget.NewCard("Kumulierte Kosten (€) nach Monaten", "", chart),
+	)
+
+	breakEvenWindow.SetContent(content)
+	breakEvenWindow.Show()
+}
@@ -0,0 +1,133 @@
+package ui
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+	"fmt"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+func (a *App) createChargingMixSection() *fyne.Container {
+	translations := a.getCurrentTranslations()
+
+	a.chargingSourcesBox = container.NewVBox()
+	addButton := widget.NewButtonWithIcon(translations.AddChargingSource, theme.ContentAddIcon(), func() {
+		if a.currentProfile == nil {
+			return
+		}
+		a.currentProfile.ChargingSources = append(a.currentProfile.ChargingSources, models.ChargingSource{
+			Type: models.HomeWallbox,
+		})
+		a.updateChargingSourceRows()
+	})
+
+	header := container.NewGridWithColumns(4,
+		widget.NewLabelWithStyle(translations.ChargingSourceType, fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		widget.NewLabelWithStyle(translations.ChargingSourcePrice, fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		widget.NewLabelWithStyle(translations.ChargingSourceShare, fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		widget.NewLabelWithStyle(translations.ChargingEfficiency, fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+	)
+
+	return container.NewVBox(
+		widget.NewCard(translations.ChargingMixTitle, "", container.NewVBox(
+			header,
+			a.chargingSourcesBox,
+			addButton,
+		)),
+	)
+}
+
+// updateChargingSourceRows rebuilds the editable rows from the current profile.
+func (a *App) updateChargingSourceRows() {
+	if a.chargingSourcesBox == nil {
+		return
+	}
+
+	a.chargingSourcesBox.RemoveAll()
+	if a.currentProfile == nil {
+		return
+	}
+
+	for i := range a.currentProfile.ChargingSources {
+		a.chargingSourcesBox.Add(a.createChargingSourceRow(i))
+	}
+	a.updateResults()
+}
+
+func (a *App) createChargingSourceRow(index int) fyne.CanvasObject {
+	source := a.currentProfile.ChargingSources[index]
+
+	typeSelect := widget.NewSelect(a.getTranslatedElectricityTypes(), func(value string) {
+		a.currentProfile.ChargingSources[index].Type = models.ElectricityType(a.getElectricityTypeFromTranslation(value))
+		a.updateResults()
+	})
+	typeSelect.SetSelected(a.translateElectricityType(string(source.Type)))
+
+	priceEntry := widget.NewEntry()
+	priceEntry.SetPlaceHolder("z.B. 0,32")
+	priceEntry.SetText(FormatGermanNumber(source.Price, 2))
+	priceEntry.OnChanged = func(text string) {
+		value, err := ParseGermanNumber(text)
+		if err != nil && text != "" {
+			return // Invalid number, skip update
+		}
+		a.currentProfile.ChargingSources[index].Price = value
+		a.updateResults()
+	}
+
+	shareEntry := widget.NewEntry()
+	shareEntry.SetPlaceHolder("z.B. 70")
+	shareEntry.SetText(FormatGermanNumber(source.Share, 0))
+	shareEntry.OnChanged = func(text string) {
+		value, err := ParseGermanNumber(text)
+		if err != nil && text != "" {
+			return // Invalid number, skip update
+		}
+		a.currentProfile.ChargingSources[index].Share = value
+		a.updateResults()
+	}
+
+	// Empty uses the default efficiency of the charging type
+	efficiencyEntry := widget.NewEntry()
+	efficiencyEntry.SetPlaceHolder("Standard")
+	if source.Efficiency > 0 {
+		efficiencyEntry.SetText(FormatGermanNumber(source.Efficiency, 0))
+	}
+	efficiencyEntry.OnChanged = func(text string) {
+		value, err := ParseGermanNumber(text)
+		if err != nil && text != "" {
+			return // Invalid number, skip update
+		}
+		a.currentProfile.ChargingSources[index].Efficiency = value
+		a.updateResults()
+	}
+
+	deleteButton := widget.NewButtonWithIcon("", theme.DeleteIcon(), func() {
+		sources := a.currentProfile.ChargingSources
+		a.currentProfile.ChargingSources = append(sources[:index:index], sources[index+1:]...)
+		a.updateChargingSourceRows()
+	})
+
+	return container.NewBorder(nil, nil, nil, deleteButton,
+		container.NewGridWithColumns(4, typeSelect, priceEntry, shareEntry, efficiencyEntry))
+}
+
+func (a *App) createChargingSummary(calculation *models.CostCalculation) *fyne.Container {
+	content := container.NewVBox(
+		widget.NewLabel("Mischpreis: " + FormatGermanNumber(calculation.BlendedElectricityPrice, 3) + " €/kWh"),
+	)
+
+	for _, cost := range calculation.ChargingBreakdown {
+		content.Add(widget.NewLabel(fmt.Sprintf("%s (%s, Wirkungsgrad %s): %s aus dem Netz, %s",
+			a.translateElectricityType(string(cost.Type)),
+			FormatPercentage(cost.Share),
+			FormatPercentage(cost.Efficiency),
+			FormatKWh(cost.GridEnergy),
+			FormatCurrency(cost.Monthly))))
+	}
+
+	return content
+}
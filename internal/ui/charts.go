@@ -0,0 +1,459 @@
+package ui
+
+import (
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Chart colors shared by all charts
+var (
+	chartColorElectric   = color.NRGBA{R: 39, G: 174, B: 96, A: 255}
+	chartColorCombustion = color.NRGBA{R: 231, G: 76, B: 60, A: 255}
+	chartColorMarker     = color.NRGBA{R: 243, G: 156, B: 18, A: 255}
+	chartColorLow        = color.NRGBA{R: 52, G: 152, B: 219, A: 255}
+	chartColorHigh       = color.NRGBA{R: 142, G: 68, B: 173, A: 255}
+)
+
+// Space reserved around the plot area for axis labels and the legend
+const (
+	chartMarginLeft   = 90
+	chartMarginRight  = 20
+	chartMarginTop    = 30
+	chartMarginBottom = 30
+	chartTicks        = 5
+)
+
+type chartSeries struct {
+	Name   string
+	Color  color.Color
+	Values []float64
+}
+
+// lineChart draws one or more series over a shared x axis. Hovering or
+// tapping the plot moves a cursor to the nearest x value and reports its
+// index through OnHover.
+type lineChart struct {
+	widget.BaseWidget
+
+	XValues []float64
+	Series  []chartSeries
+	Marker  float64 // x value to highlight, NaN for none
+	FormatX func(float64) string
+	FormatY func(float64) string
+	OnHover func(index int)
+
+	cursor int
+}
+
+func newLineChart() *lineChart {
+	chart := &lineChart{
+		Marker:  math.NaN(),
+		FormatX: func(v float64) string { return FormatGermanNumber(v, 0) },
+		FormatY: func(v float64) string { return FormatGermanNumber(v, 0) },
+		cursor:  -1,
+	}
+	chart.ExtendBaseWidget(chart)
+	return chart
+}
+
+// SetData replaces the chart data and resets the cursor.
+func (c *lineChart) SetData(xValues []float64, series []chartSeries, marker float64) {
+	c.XValues = xValues
+	c.Series = series
+	c.Marker = marker
+	c.cursor = -1
+	c.Refresh()
+}
+
+func (c *lineChart) CreateRenderer() fyne.WidgetRenderer {
+	return &lineChartRenderer{chart: c}
+}
+
+func (c *lineChart) MinSize() fyne.Size {
+	return fyne.NewSize(400, 250)
+}
+
+func (c *lineChart) Tapped(ev *fyne.PointEvent) {
+	c.moveCursor(ev.Position)
+}
+
+func (c *lineChart) MouseIn(ev *desktop.MouseEvent) {
+	c.moveCursor(ev.Position)
+}
+
+func (c *lineChart) MouseMoved(ev *desktop.MouseEvent) {
+	c.moveCursor(ev.Position)
+}
+
+func (c *lineChart) MouseOut() {}
+
+func (c *lineChart) moveCursor(pos fyne.Position) {
+	if len(c.XValues) == 0 {
+		return
+	}
+
+	minX, maxX := c.xRange()
+	plotWidth := c.Size().Width - chartMarginLeft - chartMarginRight
+	if plotWidth <= 0 {
+		return
+	}
+
+	x := minX + float64((pos.X-chartMarginLeft)/plotWidth)*(maxX-minX)
+	nearest := 0
+	for i, value := range c.XValues {
+		if math.Abs(value-x) < math.Abs(c.XValues[nearest]-x) {
+			nearest = i
+		}
+	}
+
+	if nearest == c.cursor {
+		return
+	}
+	c.cursor = nearest
+	c.Refresh()
+	if c.OnHover != nil {
+		c.OnHover(nearest)
+	}
+}
+
+func (c *lineChart) xRange() (float64, float64) {
+	if len(c.XValues) == 0 {
+		return 0, 1
+	}
+	minX, maxX := c.XValues[0], c.XValues[len(c.XValues)-1]
+	if maxX == minX {
+		maxX = minX + 1
+	}
+	return minX, maxX
+}
+
+func (c *lineChart) yRange() (float64, float64) {
+	minY, maxY := 0.0, 0.0
+	for _, series := range c.Series {
+		for _, value := range series.Values {
+			minY = math.Min(minY, value)
+			maxY = math.Max(maxY, value)
+		}
+	}
+	step := niceStep((maxY - minY) / chartTicks)
+	minY = math.Floor(minY/step) * step
+	maxY = math.Ceil(maxY/step) * step
+	if maxY == minY {
+		maxY = minY + step
+	}
+	return minY, maxY
+}
+
+type lineChartRenderer struct {
+	chart   *lineChart
+	objects []fyne.CanvasObject
+}
+
+func (r *lineChartRenderer) Layout(size fyne.Size) {
+	r.build(size)
+}
+
+func (r *lineChartRenderer) MinSize() fyne.Size {
+	return r.chart.MinSize()
+}
+
+func (r *lineChartRenderer) Refresh() {
+	r.build(r.chart.Size())
+	canvas.Refresh(r.chart)
+}
+
+func (r *lineChartRenderer) Objects() []fyne.CanvasObject {
+	return r.objects
+}
+
+func (r *lineChartRenderer) Destroy() {}
+
+func (r *lineChartRenderer) build(size fyne.Size) {
+	c := r.chart
+	r.objects = nil
+
+	plotWidth := size.Width - chartMarginLeft - chartMarginRight
+	plotHeight := size.Height - chartMarginTop - chartMarginBottom
+	if plotWidth <= 0 || plotHeight <= 0 || len(c.XValues) == 0 {
+		return
+	}
+
+	minX, maxX := c.xRange()
+	minY, maxY := c.yRange()
+	toPoint := func(x, y float64) fyne.Position {
+		return fyne.NewPos(
+			chartMarginLeft+float32((x-minX)/(maxX-minX))*plotWidth,
+			chartMarginTop+plotHeight-float32((y-minY)/(maxY-minY))*plotHeight,
+		)
+	}
+
+	gridColor := theme.Color(theme.ColorNameSeparator)
+	textColor := theme.Color(theme.ColorNameForeground)
+
+	// Horizontal grid lines with y labels
+	for i := 0; i <= chartTicks; i++ {
+		y := minY + (maxY-minY)*float64(i)/chartTicks
+		pos := toPoint(minX, y)
+		r.addLine(pos, fyne.NewPos(chartMarginLeft+plotWidth, pos.Y), gridColor, 1)
+
+		label := canvas.NewText(c.FormatY(y), textColor)
+		label.TextSize = theme.CaptionTextSize()
+		label.Alignment = fyne.TextAlignTrailing
+		label.Move(fyne.NewPos(0, pos.Y-label.MinSize().Height/2))
+		label.Resize(fyne.NewSize(chartMarginLeft-8, label.MinSize().Height))
+		r.objects = append(r.objects, label)
+	}
+
+	// X labels
+	for i := 0; i <= chartTicks; i++ {
+		x := minX + (maxX-minX)*float64(i)/chartTicks
+		pos := toPoint(x, minY)
+		label := canvas.NewText(c.FormatX(x), textColor)
+		label.TextSize = theme.CaptionTextSize()
+		width := label.MinSize().Width
+		label.Move(fyne.NewPos(pos.X-width/2, pos.Y+4))
+		r.objects = append(r.objects, label)
+	}
+
+	// Axes
+	origin := toPoint(minX, minY)
+	r.addLine(origin, fyne.NewPos(chartMarginLeft+plotWidth, origin.Y), textColor, 1)
+	r.addLine(origin, fyne.NewPos(origin.X, chartMarginTop), textColor, 1)
+
+	// Series
+	for _, series := range c.Series {
+		for i := 1; i < len(series.Values) && i < len(c.XValues); i++ {
+			r.addLine(
+				toPoint(c.XValues[i-1], series.Values[i-1]),
+				toPoint(c.XValues[i], series.Values[i]),
+				series.Color, 2,
+			)
+		}
+	}
+
+	// Highlighted marker, e.g. the break-even point
+	if !math.IsNaN(c.Marker) && c.Marker >= minX && c.Marker <= maxX {
+		top := toPoint(c.Marker, maxY)
+		r.addLine(top, fyne.NewPos(top.X, origin.Y), chartColorMarker, 2)
+	}
+
+	// Hover cursor
+	if c.cursor >= 0 && c.cursor < len(c.XValues) {
+		top := toPoint(c.XValues[c.cursor], maxY)
+		r.addLine(top, fyne.NewPos(top.X, origin.Y), textColor, 1)
+		for _, series := range c.Series {
+			if c.cursor < len(series.Values) {
+				point := toPoint(c.XValues[c.cursor], series.Values[c.cursor])
+				dot := canvas.NewCircle(series.Color)
+				dot.Move(point.SubtractXY(4, 4))
+				dot.Resize(fyne.NewSize(8, 8))
+				r.objects = append(r.objects, dot)
+			}
+		}
+	}
+
+	// Legend
+	legendX := float32(chartMarginLeft)
+	for _, series := range c.Series {
+		swatch := canvas.NewRectangle(series.Color)
+		swatch.Move(fyne.NewPos(legendX, 8))
+		swatch.Resize(fyne.NewSize(12, 12))
+		label := canvas.NewText(series.Name, textColor)
+		label.TextSize = theme.CaptionTextSize()
+		label.Move(fyne.NewPos(legendX+16, 6))
+		r.objects = append(r.objects, swatch, label)
+		legendX += 16 + label.MinSize().Width + 16
+	}
+}
+
+func (r *lineChartRenderer) addLine(from, to fyne.Position, lineColor color.Color, width float32) {
+	line := canvas.NewLine(lineColor)
+	line.StrokeWidth = width
+	line.Position1 = from
+	line.Position2 = to
+	r.objects = append(r.objects, line)
+}
+
+// tornadoBar is one input of a tornado chart with the result for the
+// lowered and the raised input.
+type tornadoBar struct {
+	Label string
+	Low   float64
+	High  float64
+}
+
+// tornadoChart draws horizontal bars around a base value, sorted as given,
+// so the inputs with the largest effect form the wide top of the tornado.
+type tornadoChart struct {
+	widget.BaseWidget
+
+	Base      float64
+	Bars      []tornadoBar
+	LowLabel  string
+	HighLabel string
+	FormatX   func(float64) string
+}
+
+// Space reserved for the input labels of a tornado chart
+const (
+	tornadoLabelWidth = 160
+	tornadoBarHeight  = 22
+)
+
+func newTornadoChart() *tornadoChart {
+	chart := &tornadoChart{
+		FormatX: func(v float64) string { return FormatGermanNumber(v, 0) },
+	}
+	chart.ExtendBaseWidget(chart)
+	return chart
+}
+
+// SetData replaces the chart data.
+func (c *tornadoChart) SetData(base float64, bars []tornadoBar, lowLabel, highLabel string) {
+	c.Base = base
+	c.Bars = bars
+	c.LowLabel = lowLabel
+	c.HighLabel = highLabel
+	c.Refresh()
+}
+
+func (c *tornadoChart) CreateRenderer() fyne.WidgetRenderer {
+	return &tornadoChartRenderer{chart: c}
+}
+
+func (c *tornadoChart) MinSize() fyne.Size {
+	return fyne.NewSize(400, chartMarginTop+chartMarginBottom+float32(max(len(c.Bars), 1))*(tornadoBarHeight+6))
+}
+
+func (c *tornadoChart) xRange() (float64, float64) {
+	minX, maxX := c.Base, c.Base
+	for _, bar := range c.Bars {
+		minX = math.Min(minX, math.Min(bar.Low, bar.High))
+		maxX = math.Max(maxX, math.Max(bar.Low, bar.High))
+	}
+	if maxX == minX {
+		maxX = minX + 1
+	}
+	return minX, maxX
+}
+
+type tornadoChartRenderer struct {
+	chart   *tornadoChart
+	objects []fyne.CanvasObject
+}
+
+func (r *tornadoChartRenderer) Layout(size fyne.Size) {
+	r.build(size)
+}
+
+func (r *tornadoChartRenderer) MinSize() fyne.Size {
+	return r.chart.MinSize()
+}
+
+func (r *tornadoChartRenderer) Refresh() {
+	r.build(r.chart.Size())
+	canvas.Refresh(r.chart)
+}
+
+func (r *tornadoChartRenderer) Objects() []fyne.CanvasObject {
+	return r.objects
+}
+
+func (r *tornadoChartRenderer) Destroy() {}
+
+func (r *tornadoChartRenderer) build(size fyne.Size) {
+	c := r.chart
+	r.objects = nil
+
+	plotWidth := size.Width - tornadoLabelWidth - chartMarginRight
+	if plotWidth <= 0 || len(c.Bars) == 0 {
+		return
+	}
+
+	minX, maxX := c.xRange()
+	toX := func(x float64) float32 {
+		return tornadoLabelWidth + float32((x-minX)/(maxX-minX))*plotWidth
+	}
+
+	textColor := theme.Color(theme.ColorNameForeground)
+	baseX := toX(c.Base)
+	bottom := float32(chartMarginTop)
+
+	for i, bar := range c.Bars {
+		y := float32(chartMarginTop) + float32(i)*(tornadoBarHeight+6)
+		bottom = y + tornadoBarHeight
+
+		label := canvas.NewText(bar.Label, textColor)
+		label.TextSize = theme.CaptionTextSize()
+		label.Alignment = fyne.TextAlignTrailing
+		label.Move(fyne.NewPos(0, y+(tornadoBarHeight-label.MinSize().Height)/2))
+		label.Resize(fyne.NewSize(tornadoLabelWidth-8, label.MinSize().Height))
+		r.objects = append(r.objects, label)
+
+		r.addBar(baseX, toX(bar.Low), y, chartColorLow)
+		r.addBar(baseX, toX(bar.High), y, chartColorHigh)
+	}
+
+	// Base value line with the value range below
+	line := canvas.NewLine(textColor)
+	line.Position1 = fyne.NewPos(baseX, chartMarginTop-4)
+	line.Position2 = fyne.NewPos(baseX, bottom+4)
+	r.objects = append(r.objects, line)
+
+	for _, x := range []float64{minX, c.Base, maxX} {
+		label := canvas.NewText(c.FormatX(x), textColor)
+		label.TextSize = theme.CaptionTextSize()
+		width := label.MinSize().Width
+		posX := min(max(toX(x)-width/2, tornadoLabelWidth), size.Width-width)
+		label.Move(fyne.NewPos(posX, bottom+6))
+		r.objects = append(r.objects, label)
+	}
+
+	// Legend
+	legendX := float32(tornadoLabelWidth)
+	for _, entry := range []struct {
+		name  string
+		color color.Color
+	}{{c.LowLabel, chartColorLow}, {c.HighLabel, chartColorHigh}} {
+		swatch := canvas.NewRectangle(entry.color)
+		swatch.Move(fyne.NewPos(legendX, 8))
+		swatch.Resize(fyne.NewSize(12, 12))
+		label := canvas.NewText(entry.name, textColor)
+		label.TextSize = theme.CaptionTextSize()
+		label.Move(fyne.NewPos(legendX+16, 6))
+		r.objects = append(r.objects, swatch, label)
+		legendX += 16 + label.MinSize().Width + 16
+	}
+}
+
+// addBar adds a bar between two x positions at the given row.
+func (r *tornadoChartRenderer) addBar(from, to, y float32, barColor color.Color) {
+	rect := canvas.NewRectangle(barColor)
+	rect.Move(fyne.NewPos(min(from, to), y))
+	rect.Resize(fyne.NewSize(float32(math.Abs(float64(to-from))), tornadoBarHeight))
+	r.objects = append(r.objects, rect)
+}
+
+// niceStep rounds a raw axis step to 1, 2 or 5 times a power of ten.
+func niceStep(raw float64) float64 {
+	if raw <= 0 {
+		return 1
+	}
+	magnitude := math.Pow(10, math.Floor(math.Log10(raw)))
+	switch fraction := raw / magnitude; {
+	case fraction <= 1:
+		return magnitude
+	case fraction <= 2:
+		return 2 * magnitude
+	case fraction <= 5:
+		return 5 * magnitude
+	default:
+		return 10 * magnitude
+	}
+}
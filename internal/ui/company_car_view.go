@@ -0,0 +1,56 @@
+package ui
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+	"fmt"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+)
+
+func (a *App) createCompanyCarSummary(calculation *models.CostCalculation) *fyne.Container {
+	content := container.NewVBox(
+		widget.NewLabel(fmt.Sprintf("Pauschal (%s des Listenpreises): %s",
+			FormatGermanNumber(calculation.CompanyCarRate, 2)+" %",
+			FormatCurrency(calculation.CompanyCarListPriceBenefit))),
+	)
+
+	if calculation.CompanyCarCommuteBenefit > 0 {
+		content.Add(widget.NewLabel("Zuschlag Arbeitsweg (0,03%): " + FormatCurrency(calculation.CompanyCarCommuteBenefit)))
+	}
+	content.Add(widget.NewLabel("Geldwerter Vorteil pauschal: " + FormatCurrency(calculation.CompanyCarFlatRateBenefit)))
+
+	if calculation.Profile.PrivateUseShare > 0 {
+		content.Add(widget.NewLabel("Geldwerter Vorteil laut Fahrtenbuch: " + FormatCurrency(calculation.CompanyCarLogbookBenefit)))
+	}
+
+	content.Add(widget.NewSeparator())
+	content.Add(widget.NewLabel(fmt.Sprintf("Versteuerter Vorteil (%s): %s",
+		a.translateCompanyCarMethod(string(calculation.Profile.CompanyCarMethod)),
+		FormatCurrency(calculation.CompanyCarBenefit))))
+	content.Add(widget.NewLabelWithStyle("Nettogehalt weniger pro Monat: "+FormatCurrency(calculation.MonthlyCompanyCarCost),
+		fyne.TextAlignLeading, fyne.TextStyle{Bold: true}))
+	content.Add(widget.NewLabel("Nettogehalt weniger pro Jahr: " + FormatCurrency(calculation.AnnualCompanyCarCost)))
+	content.Add(widget.NewLabel("Nettogehalt weniger über Haltedauer: " + FormatCurrency(calculation.CompanyCarTotalCost)))
+	_, _, _, costPerKm := driverCosts(calculation)
+	content.Add(widget.NewLabel("Ihre Kosten pro Kilometer: " + FormatCurrency(costPerKm)))
+
+	return content
+}
+
+// driverCosts returns the monthly and annual running costs, the total cost
+// of ownership and the cost per km borne by the driver. For a company car
+// this is the reduction of the net salary, the employer pays the car.
+func driverCosts(calculation *models.CostCalculation) (monthly, annual, total, costPerKm float64) {
+	if !calculation.Profile.CompanyCar {
+		return calculation.MonthlyRunningCosts, calculation.AnnualRunningCosts,
+			calculation.TotalCostOfOwnership, calculation.CostPerKilometer
+	}
+
+	total = calculation.CompanyCarTotalCost
+	if km := calculation.Profile.MonthlyKilometers * float64(len(calculation.Timeline)); km > 0 {
+		costPerKm = total / km
+	}
+	return calculation.MonthlyCompanyCarCost, calculation.AnnualCompanyCarCost, total, costPerKm
+}
@@ -0,0 +1,95 @@
+package ui
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+func (a *App) createCustomCostsSection() *fyne.Container {
+	translations := a.getCurrentTranslations()
+
+	a.customCostsBox = container.NewVBox()
+	addButton := widget.NewButtonWithIcon(translations.AddCustomCost, theme.ContentAddIcon(), func() {
+		if a.currentProfile == nil {
+			return
+		}
+		a.currentProfile.CustomCosts = append(a.currentProfile.CustomCosts, models.CustomCostItem{
+			Recurrence: models.RecurrenceMonthly,
+		})
+		a.updateCustomCostRows()
+	})
+
+	header := container.NewGridWithColumns(3,
+		widget.NewLabelWithStyle(translations.CustomCostName, fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		widget.NewLabelWithStyle(translations.CustomCostAmount, fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		widget.NewLabelWithStyle(translations.CustomCostRecurrence, fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+	)
+
+	return container.NewVBox(
+		widget.NewCard(translations.CustomCostsTitle, "", container.NewVBox(
+			header,
+			a.customCostsBox,
+			addButton,
+		)),
+	)
+}
+
+// updateCustomCostRows rebuilds the editable rows from the current profile.
+func (a *App) updateCustomCostRows() {
+	if a.customCostsBox == nil {
+		return
+	}
+
+	a.customCostsBox.RemoveAll()
+	if a.currentProfile == nil {
+		return
+	}
+
+	for i := range a.currentProfile.CustomCosts {
+		a.customCostsBox.Add(a.createCustomCostRow(i))
+	}
+	a.updateResults()
+}
+
+func (a *App) createCustomCostRow(index int) fyne.CanvasObject {
+	item := a.currentProfile.CustomCosts[index]
+
+	nameEntry := widget.NewEntry()
+	nameEntry.SetPlaceHolder("z.B. Garagenmiete")
+	nameEntry.SetText(item.Name)
+	nameEntry.OnChanged = func(text string) {
+		a.currentProfile.CustomCosts[index].Name = text
+		a.updateResults()
+	}
+
+	amountEntry := widget.NewEntry()
+	amountEntry.SetPlaceHolder("z.B. 80")
+	amountEntry.SetText(FormatGermanNumber(item.Amount, 2))
+	amountEntry.OnChanged = func(text string) {
+		value, err := ParseGermanNumber(text)
+		if err != nil && text != "" {
+			return // Invalid number, skip update
+		}
+		a.currentProfile.CustomCosts[index].Amount = value
+		a.updateResults()
+	}
+
+	recurrenceSelect := widget.NewSelect(a.getTranslatedCostRecurrences(), func(value string) {
+		a.currentProfile.CustomCosts[index].Recurrence = models.CostRecurrence(a.getCostRecurrenceFromTranslation(value))
+		a.updateResults()
+	})
+	recurrenceSelect.SetSelected(a.translateCostRecurrence(string(item.Recurrence)))
+
+	deleteButton := widget.NewButtonWithIcon("", theme.DeleteIcon(), func() {
+		costs := a.currentProfile.CustomCosts
+		a.currentProfile.CustomCosts = append(costs[:index:index], costs[index+1:]...)
+		a.updateCustomCostRows()
+	})
+
+	return container.NewBorder(nil, nil, nil, deleteButton,
+		container.NewGridWithColumns(3, nameEntry, amountEntry, recurrenceSelect))
+}
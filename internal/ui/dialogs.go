@@ -1,9 +1,11 @@
 package ui
 
 import (
+	"auto-unterhaltsrechner/internal/calculator"
 	"auto-unterhaltsrechner/internal/models"
 	"encoding/csv"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -67,6 +69,9 @@ func (a *App) showExportDialog() {
 	}
 
 	exportOptions := []string{"CSV Export", "JSON Export", "PDF Export"}
+	if len(calculation.AmortizationSchedule) > 0 {
+		exportOptions = append(exportOptions, "Tilgungsplan (CSV)")
+	}
 	exportSelect := widget.NewSelect(exportOptions, nil)
 	exportSelect.SetSelected("CSV Export")
 
@@ -84,6 +89,8 @@ func (a *App) showExportDialog() {
 					a.exportToJSON(a.currentProfile)
 				case "PDF Export":
 					a.exportToPDF(calculation)
+				case "Tilgungsplan (CSV)":
+					a.exportAmortizationToCSV(calculation)
 				}
 			}
 		}, a.window)
@@ -108,36 +115,144 @@ func (a *App) exportToCSV(calculation *models.CostCalculation) {
 
 		// Write data
 		csvWriter.Write([]string{"Kraftstoff", "Kraftstoffkosten",
-			FormatGermanNumber(calculation.MonthlyFuelCost, 2),
+			FormatGermanNumber(calculation.AnnualFuelCost/12, 2),
 			FormatGermanNumber(calculation.AnnualFuelCost, 2)})
 
 		csvWriter.Write([]string{"Strom", "Stromkosten",
-			FormatGermanNumber(calculation.MonthlyElectricityCost, 2),
+			FormatGermanNumber(calculation.AnnualElectricityCost/12, 2),
 			FormatGermanNumber(calculation.AnnualElectricityCost, 2)})
 
+		if calculation.MonthlyElectricityAmount > 0 {
+			for _, cost := range calculation.ChargingBreakdown {
+				csvWriter.Write([]string{"Lademix", fmt.Sprintf("%s (%s)",
+					a.translateElectricityType(string(cost.Type)), FormatPercentage(cost.Share)),
+					FormatGermanNumber(cost.Monthly, 2),
+					FormatGermanNumber(cost.Monthly*12, 2)})
+			}
+		}
+
 		csvWriter.Write([]string{"Steuer", "KFZ-Steuer",
-			FormatGermanNumber(calculation.Profile.AnnualCarTax/12, 2),
-			FormatGermanNumber(calculation.Profile.AnnualCarTax, 2)})
+			FormatGermanNumber(calculation.AnnualTaxCost/12, 2),
+			FormatGermanNumber(calculation.AnnualTaxCost, 2)})
 
 		csvWriter.Write([]string{"Versicherung", "Versicherung",
-			FormatGermanNumber(calculation.Profile.AnnualCarInsurance/12, 2),
-			FormatGermanNumber(calculation.Profile.AnnualCarInsurance, 2)})
+			FormatGermanNumber(calculation.AnnualInsuranceCost/12, 2),
+			FormatGermanNumber(calculation.AnnualInsuranceCost, 2)})
+
+		maintenanceRows := []struct {
+			name    string
+			monthly float64
+		}{
+			{"Inspektion", calculation.MonthlyServiceCost},
+			{"HU/AU", calculation.MonthlyInspectionCost},
+			{"Reifen", calculation.MonthlyTireCost},
+			{"Bremsen", calculation.MonthlyBrakeCost},
+			{"Reparaturrücklage", calculation.MonthlyRepairReserve},
+		}
+		for _, row := range maintenanceRows {
+			csvWriter.Write([]string{"Wartung", row.name,
+				FormatGermanNumber(row.monthly, 2),
+				FormatGermanNumber(row.monthly*12, 2)})
+		}
+
+		if batteryCost := firstYearBatteryCost(calculation); batteryCost > 0 {
+			csvWriter.Write([]string{"Batterie", "Batterietausch",
+				FormatGermanNumber(batteryCost/12, 2),
+				FormatGermanNumber(batteryCost, 2)})
+		}
+
+		for _, cost := range calculation.CustomCostBreakdown {
+			if cost.Recurrence == models.RecurrenceOnce {
+				csvWriter.Write([]string{"Weitere Kosten", cost.Name + " (einmalig)",
+					"", FormatGermanNumber(cost.OneOff, 2)})
+				continue
+			}
+			csvWriter.Write([]string{"Weitere Kosten", cost.Name,
+				FormatGermanNumber(cost.Monthly, 2),
+				FormatGermanNumber(cost.Monthly*12, 2)})
+		}
+
+		if calculation.MonthlyRevenue > 0 {
+			csvWriter.Write([]string{"Gutschriften", "THG-Quote",
+				FormatGermanNumber(-calculation.MonthlyRevenue, 2),
+				FormatGermanNumber(-calculation.AnnualRevenue, 2)})
+		}
+		if calculation.PurchaseIncentives > 0 {
+			csvWriter.Write([]string{"Gutschriften", "Kaufprämien (einmalig)",
+				"", FormatGermanNumber(-calculation.PurchaseIncentives, 2)})
+		}
 
 		csvWriter.Write([]string{"Finanzierung", "Finanzierung",
-			FormatGermanNumber(calculation.Profile.FinancingRate, 2),
-			FormatGermanNumber(calculation.Profile.FinancingRate*12, 2)})
+			FormatGermanNumber(calculation.MonthlyFinancingCost, 2),
+			FormatGermanNumber(calculation.AnnualFinancingCost, 2)})
 
 		csvWriter.Write([]string{"Gesamt", "Gesamtkosten",
 			FormatGermanNumber(calculation.MonthlyRunningCosts, 2),
 			FormatGermanNumber(calculation.AnnualRunningCosts, 2)})
 
+		if calculation.FinancingMonths > 0 && calculation.FinancingMonths < len(calculation.Timeline) {
+			csvWriter.Write([]string{"Gesamt", fmt.Sprintf("Gesamtkosten ab Monat %d", calculation.FinancingMonths+1),
+				FormatGermanNumber(calculation.MonthlyCostsAfterFinancing, 2),
+				FormatGermanNumber(calculation.MonthlyCostsAfterFinancing*12, 2)})
+		}
+
+		if calculation.Profile.AcquisitionType == models.AcquisitionLease {
+			csvWriter.Write([]string{"Leasing", "Leasingsonderzahlung",
+				"", FormatGermanNumber(calculation.Profile.LeaseSpecialPayment, 2)})
+			csvWriter.Write([]string{"Leasing", "Kilometerausgleich bei Vertragsende",
+				"", FormatGermanNumber(calculation.LeaseSettlement, 2)})
+		}
+
+		for _, year := range calculation.YearlyProjection {
+			csvWriter.Write([]string{"Prognose", fmt.Sprintf("Kosten in Jahr %d", year.Year),
+				"", FormatGermanNumber(year.Total, 2)})
+		}
+
 		csvWriter.Write([]string{"Wertverlust", "Jährlicher Wertverlust",
 			FormatGermanNumber(calculation.AnnualDepreciation/12, 2),
 			FormatGermanNumber(calculation.AnnualDepreciation, 2)})
 
+		for i, value := range calculation.ResidualValues {
+			csvWriter.Write([]string{"Wertverlust", fmt.Sprintf("Restwert nach Jahr %d", i+1),
+				"", FormatGermanNumber(value, 2)})
+		}
+
 		csvWriter.Write([]string{"Kennzahlen", "Kosten pro Kilometer",
 			FormatGermanNumber(calculation.CostPerKilometer, 4), ""})
 
+		csvWriter.Write([]string{"Kennzahlen", "Gesamtkosten der Nutzung",
+			"", FormatGermanNumber(calculation.TotalCostOfOwnership, 2)})
+
+		csvWriter.Write([]string{"Kennzahlen", "Gesamtkosten nominal",
+			"", FormatGermanNumber(calculation.NominalCostOfOwnership, 2)})
+
+		csvWriter.Write([]string{"Kennzahlen", "Barwert der Gesamtkosten",
+			"", FormatGermanNumber(calculation.PresentCostOfOwnership, 2)})
+
+		csvWriter.Write([]string{"Kennzahlen", "Kapitalkosten",
+			"", FormatGermanNumber(calculation.CapitalCost, 2)})
+
+		csvWriter.Write([]string{"Kennzahlen", "Äquivalente jährliche Kosten",
+			"", FormatGermanNumber(calculation.EquivalentAnnualCost, 2)})
+
+		if calculation.Profile.CompanyCar {
+			csvWriter.Write([]string{"Dienstwagen", "Geldwerter Vorteil pauschal",
+				FormatGermanNumber(calculation.CompanyCarFlatRateBenefit, 2),
+				FormatGermanNumber(calculation.CompanyCarFlatRateBenefit*12, 2)})
+			csvWriter.Write([]string{"Dienstwagen", "Geldwerter Vorteil laut Fahrtenbuch",
+				FormatGermanNumber(calculation.CompanyCarLogbookBenefit, 2),
+				FormatGermanNumber(calculation.CompanyCarLogbookBenefit*12, 2)})
+			csvWriter.Write([]string{"Dienstwagen", "Nettogehalt weniger",
+				FormatGermanNumber(calculation.MonthlyCompanyCarCost, 2),
+				FormatGermanNumber(calculation.AnnualCompanyCarCost, 2)})
+		}
+
+		csvWriter.Write([]string{"CO2", "CO2-Emissionen (kg)",
+			FormatGermanNumber(calculation.MonthlyCO2, 1),
+			FormatGermanNumber(calculation.AnnualCO2, 1)})
+		csvWriter.Write([]string{"CO2", "CO2-Emissionen über Haltedauer (kg)",
+			"", FormatGermanNumber(calculation.LifetimeCO2, 1)})
+
 		dialog.ShowInformation("Export erfolgreich", "Die Daten wurden erfolgreich exportiert.", a.window)
 	}, a.window)
 
@@ -249,11 +364,26 @@ func (a *App) exportToPDF(calculation *models.CostCalculation) {
 
 		// Monthly costs table
 		monthlyData := [][]string{
-			{translations.FuelCosts[:len(translations.FuelCosts)-2], FormatCurrencyPDF(calculation.MonthlyFuelCost)},
-			{translations.ElectricityCosts[:len(translations.ElectricityCosts)-2], FormatCurrencyPDF(calculation.MonthlyElectricityCost)},
-			{translations.TaxCosts[:len(translations.TaxCosts)-2], FormatCurrencyPDF(calculation.Profile.AnnualCarTax / 12)},
-			{translations.InsuranceCosts[:len(translations.InsuranceCosts)-2], FormatCurrencyPDF(calculation.Profile.AnnualCarInsurance / 12)},
-			{translations.FinancingCosts[:len(translations.FinancingCosts)-2], FormatCurrencyPDF(calculation.Profile.FinancingRate)},
+			{translations.FuelCosts[:len(translations.FuelCosts)-2], FormatCurrencyPDF(calculation.AnnualFuelCost / 12)},
+			{translations.ElectricityCosts[:len(translations.ElectricityCosts)-2], FormatCurrencyPDF(calculation.AnnualElectricityCost / 12)},
+			{translations.TaxCosts[:len(translations.TaxCosts)-2], FormatCurrencyPDF(calculation.AnnualTaxCost / 12)},
+			{translations.InsuranceCosts[:len(translations.InsuranceCosts)-2], FormatCurrencyPDF(calculation.AnnualInsuranceCost / 12)},
+			{"Inspektion:", FormatCurrencyPDF(calculation.MonthlyServiceCost)},
+			{"HU/AU:", FormatCurrencyPDF(calculation.MonthlyInspectionCost)},
+			{"Reifen:", FormatCurrencyPDF(calculation.MonthlyTireCost)},
+			{"Bremsen:", FormatCurrencyPDF(calculation.MonthlyBrakeCost)},
+			{"Reparaturrücklage:", FormatCurrencyPDF(calculation.MonthlyRepairReserve)},
+			{translations.FinancingCosts[:len(translations.FinancingCosts)-2], FormatCurrencyPDF(calculation.MonthlyFinancingCost)},
+		}
+		if batteryCost := firstYearBatteryCost(calculation); batteryCost > 0 {
+			monthlyData = append(monthlyData, []string{"Batterietausch:", FormatCurrencyPDF(batteryCost / 12)})
+		}
+		if calculation.MonthlyRevenue > 0 {
+			monthlyData = append(monthlyData, []string{"Gutschrift THG-Quote:", FormatCurrencyPDF(-calculation.MonthlyRevenue)})
+		}
+		if calculation.FinancingMonths > 0 && calculation.FinancingMonths < len(calculation.Timeline) {
+			monthlyData = append(monthlyData, []string{fmt.Sprintf("Gesamt ab Monat %d:", calculation.FinancingMonths+1),
+				FormatCurrencyPDF(calculation.MonthlyCostsAfterFinancing)})
 		}
 		createSection(translations.ResultsMonthlyCosts, monthlyData, true, calculation.MonthlyRunningCosts)
 
@@ -261,34 +391,148 @@ func (a *App) exportToPDF(calculation *models.CostCalculation) {
 		annualData := [][]string{
 			{translations.FuelCosts[:len(translations.FuelCosts)-2], FormatCurrencyPDF(calculation.AnnualFuelCost)},
 			{translations.ElectricityCosts[:len(translations.ElectricityCosts)-2], FormatCurrencyPDF(calculation.AnnualElectricityCost)},
-			{translations.TaxCosts[:len(translations.TaxCosts)-2], FormatCurrencyPDF(calculation.Profile.AnnualCarTax)},
-			{translations.InsuranceCosts[:len(translations.InsuranceCosts)-2], FormatCurrencyPDF(calculation.Profile.AnnualCarInsurance)},
-			{translations.FinancingCosts[:len(translations.FinancingCosts)-2], FormatCurrencyPDF(calculation.Profile.FinancingRate * 12)},
+			{translations.TaxCosts[:len(translations.TaxCosts)-2], FormatCurrencyPDF(calculation.AnnualTaxCost)},
+			{translations.InsuranceCosts[:len(translations.InsuranceCosts)-2], FormatCurrencyPDF(calculation.AnnualInsuranceCost)},
+			{"Inspektion:", FormatCurrencyPDF(calculation.MonthlyServiceCost * 12)},
+			{"HU/AU:", FormatCurrencyPDF(calculation.MonthlyInspectionCost * 12)},
+			{"Reifen:", FormatCurrencyPDF(calculation.MonthlyTireCost * 12)},
+			{"Bremsen:", FormatCurrencyPDF(calculation.MonthlyBrakeCost * 12)},
+			{"Reparaturrücklage:", FormatCurrencyPDF(calculation.MonthlyRepairReserve * 12)},
+			{translations.FinancingCosts[:len(translations.FinancingCosts)-2], FormatCurrencyPDF(calculation.AnnualFinancingCost)},
+		}
+		if batteryCost := firstYearBatteryCost(calculation); batteryCost > 0 {
+			annualData = append(annualData, []string{"Batterietausch:", FormatCurrencyPDF(batteryCost)})
+		}
+		if calculation.AnnualRevenue > 0 {
+			annualData = append(annualData, []string{"Gutschrift THG-Quote:", FormatCurrencyPDF(-calculation.AnnualRevenue)})
 		}
 		createSection(translations.ResultsAnnualCosts, annualData, true, calculation.AnnualRunningCosts)
 
+		// Charging mix table
+		if calculation.MonthlyElectricityAmount > 0 {
+			chargingData := [][]string{
+				{"Mischpreis:", FormatGermanNumber(calculation.BlendedElectricityPrice, 3) + " EUR/kWh"},
+			}
+			for _, cost := range calculation.ChargingBreakdown {
+				chargingData = append(chargingData, []string{
+					fmt.Sprintf("%s (%s):", a.translateElectricityType(string(cost.Type)), FormatPercentage(cost.Share)),
+					FormatCurrencyPDF(cost.Monthly) + " / Monat",
+				})
+			}
+			createSection("Lademix", chargingData, false, 0)
+		}
+
+		// Custom costs table
+		if len(calculation.CustomCostBreakdown) > 0 {
+			var customData [][]string
+			for _, cost := range calculation.CustomCostBreakdown {
+				if cost.Recurrence == models.RecurrenceOnce {
+					customData = append(customData, []string{cost.Name + " (einmalig):", FormatCurrencyPDF(cost.OneOff)})
+					continue
+				}
+				customData = append(customData, []string{cost.Name + " (monatlich):", FormatCurrencyPDF(cost.Monthly)})
+			}
+			createSection("Weitere Kosten", customData, false, 0)
+		}
+
+		// Loan table
+		if len(calculation.AmortizationSchedule) > 0 {
+			loanData := [][]string{
+				{"Kreditbetrag:", FormatCurrencyPDF(calculation.LoanAmount)},
+				{"Anzahlung:", FormatCurrencyPDF(calculation.Profile.DownPayment)},
+				{"Effektivzins:", FormatPercentage(calculation.Profile.InterestRate)},
+				{"Monatliche Rate:", FormatCurrencyPDF(calculation.AmortizationSchedule[0].Payment)},
+				{"Laufzeit:", fmt.Sprintf("%d Monate", len(calculation.AmortizationSchedule))},
+				{"Schlussrate:", FormatCurrencyPDF(calculation.Profile.BalloonPayment)},
+				{"Zinsen gesamt:", FormatCurrencyPDF(calculation.TotalInterest)},
+			}
+			createSection("Finanzierung", loanData, false, 0)
+		}
+
+		// Lease table
+		if calculation.Profile.AcquisitionType == models.AcquisitionLease {
+			leaseData := [][]string{
+				{"Leasingsonderzahlung:", FormatCurrencyPDF(calculation.Profile.LeaseSpecialPayment)},
+				{"Monatliche Leasingrate:", FormatCurrencyPDF(calculation.Profile.FinancingRate)},
+				{"Laufzeit:", fmt.Sprintf("%d Monate", calculation.FinancingMonths)},
+				{"Vertragskilometer pro Jahr:", FormatKilometers(calculation.Profile.LeaseAnnualKilometers)},
+				{"Mehr-/Minderkilometer:", FormatKilometers(calculation.LeaseExcessKilometers)},
+				{"Kilometerausgleich:", FormatCurrencyPDF(calculation.LeaseSettlement)},
+			}
+			createSection("Leasing", leaseData, false, 0)
+		}
+
+		// Company car table
+		if calculation.Profile.CompanyCar {
+			companyCarData := [][]string{
+				{"Bruttolistenpreis:", FormatCurrencyPDF(calculation.Profile.PurchasePrice)},
+				{"Pauschalsatz pro Monat:", FormatGermanNumber(calculation.CompanyCarRate, 2) + " %"},
+				{"Zuschlag Arbeitsweg:", FormatCurrencyPDF(calculation.CompanyCarCommuteBenefit)},
+				{"Geldwerter Vorteil pauschal:", FormatCurrencyPDF(calculation.CompanyCarFlatRateBenefit)},
+				{"Geldwerter Vorteil laut Fahrtenbuch:", FormatCurrencyPDF(calculation.CompanyCarLogbookBenefit)},
+				{"Nettogehalt weniger pro Monat:", FormatCurrencyPDF(calculation.MonthlyCompanyCarCost)},
+				{"Nettogehalt weniger pro Jahr:", FormatCurrencyPDF(calculation.AnnualCompanyCarCost)},
+			}
+			createSection("Dienstwagen", companyCarData, false, 0)
+		}
+
 		// Key metrics table
 		metricsData := [][]string{
 			{translations.CostPerKilometer[:len(translations.CostPerKilometer)-2], FormatCurrencyPDF(calculation.CostPerKilometer)},
 			{"Monatliche Fahrleistung:", FormatKilometers(calculation.Profile.MonthlyKilometers)},
 			{translations.TotalOwnershipCost[:len(translations.TotalOwnershipCost)-2], FormatCurrencyPDF(calculation.TotalCostOfOwnership)},
 		}
+		metricsData = append(metricsData,
+			[]string{"Barwert der Gesamtkosten:", FormatCurrencyPDF(calculation.PresentCostOfOwnership)},
+			[]string{"Kapitalkosten:", FormatCurrencyPDF(calculation.CapitalCost)},
+			[]string{"Äquivalente jährliche Kosten:", FormatCurrencyPDF(calculation.EquivalentAnnualCost)},
+		)
+		if calculation.PurchaseIncentives > 0 {
+			metricsData = append(metricsData, []string{"Gutschrift Kaufprämien:", FormatCurrencyPDF(-calculation.PurchaseIncentives)})
+		}
 		createSection(translations.ResultsKeyMetrics, metricsData, false, 0)
 
+		// Projection table
+		if len(calculation.YearlyProjection) > 1 {
+			var projectionData [][]string
+			for _, year := range calculation.YearlyProjection {
+				projectionData = append(projectionData, []string{fmt.Sprintf("Jahr %d:", year.Year),
+					FormatCurrencyPDF(year.Total) + " (kumuliert " + FormatCurrencyPDF(year.Cumulative) + ")"})
+			}
+			createSection("Kostenprognose", projectionData, false, 0)
+		}
+
 		// Depreciation table
 		depreciationData := [][]string{
 			{"Kaufpreis:", FormatCurrencyPDF(calculation.Profile.PurchasePrice)},
 			{"Besitzdauer:", fmt.Sprintf("%d Jahre", calculation.Profile.ExpectedYearsOfOwnership)},
 			{"Jährlicher Wertverlust:", FormatCurrencyPDF(calculation.AnnualDepreciation)},
+			{"Restwert bei Verkauf:", FormatCurrencyPDF(calculation.ResidualValue)},
 		}
+		if calculation.VehicleAgeAtPurchase > 0 || calculation.Profile.OdometerAtPurchase > 0 {
+			depreciationData = append(depreciationData,
+				[]string{"Fahrzeugalter bei Kauf:", FormatGermanNumber(calculation.VehicleAgeAtPurchase, 1) + " Jahre"},
+				[]string{"Kilometerstand bei Kauf:", FormatKilometers(calculation.Profile.OdometerAtPurchase)})
+		}
+		depreciationData = append(depreciationData, []string{"Kilometerstand bei Verkauf:", FormatKilometers(calculation.OdometerAtSale)})
 		createSection(translations.ResultsDepreciation, depreciationData, false, 0)
 
 		// Consumption section if applicable
 		if calculation.Profile.FuelConsumption > 0 || calculation.Profile.ElectricConsumption > 0 {
 			var consumptionData [][]string
 
+			if mix := calculation.DrivingMix; mix.Total() > 0 {
+				consumptionData = append(consumptionData, []string{"Fahrprofil Stadt/Landstraße/Autobahn",
+					FormatGermanNumber(mix.City, 0) + " / " + FormatGermanNumber(mix.Rural, 0) + " / " + FormatGermanNumber(mix.Motorway, 0) + " %"})
+				consumptionData = append(consumptionData, []string{"Monatliche Kilometer", FormatKilometers(calculation.Profile.MonthlyKilometers)})
+			}
+
+			if calculation.Profile.FuelConsumption > 0 && calculation.Profile.ElectricConsumption > 0 {
+				consumptionData = append(consumptionData, []string{"Elektrischer Fahranteil", FormatPercentage(calculation.ElectricShare)})
+			}
+
 			if calculation.Profile.FuelConsumption > 0 {
-				monthlyFuel := (calculation.Profile.FuelConsumption * calculation.Profile.MonthlyKilometers) / 100
+				monthlyFuel := calculation.MonthlyFuelAmount
 				annualFuel := monthlyFuel * 12
 				consumptionData = append(consumptionData, []string{translations.MonthlyFuelAmount[:len(translations.MonthlyFuelAmount)-2], FormatLiters(monthlyFuel)})
 				consumptionData = append(consumptionData, []string{translations.AnnualFuelAmount[:len(translations.AnnualFuelAmount)-2], FormatLiters(annualFuel)})
@@ -300,10 +544,12 @@ func (a *App) exportToPDF(calculation *models.CostCalculation) {
 			}
 
 			if calculation.Profile.ElectricConsumption > 0 {
-				monthlyElectric := (calculation.Profile.ElectricConsumption * calculation.Profile.MonthlyKilometers) / 100
+				monthlyElectric := calculation.MonthlyElectricityAmount
 				annualElectric := monthlyElectric * 12
 				consumptionData = append(consumptionData, []string{translations.MonthlyElectricAmount[:len(translations.MonthlyElectricAmount)-2], FormatKWh(monthlyElectric)})
 				consumptionData = append(consumptionData, []string{translations.AnnualElectricAmount[:len(translations.AnnualElectricAmount)-2], FormatKWh(annualElectric)})
+				consumptionData = append(consumptionData, []string{"Monatlicher Strombezug aus dem Netz", FormatKWh(calculation.MonthlyGridEnergy)})
+				consumptionData = append(consumptionData, []string{"Ladeverluste pro Monat", FormatKWh(calculation.MonthlyGridEnergy - monthlyElectric)})
 
 				if calculation.Profile.BatterySize > 0 {
 					chargesPerMonth := monthlyElectric / calculation.Profile.BatterySize
@@ -314,6 +560,16 @@ func (a *App) exportToPDF(calculation *models.CostCalculation) {
 			createSection(translations.ResultsConsumption, consumptionData, false, 0)
 		}
 
+		// Energy costs per calendar month of the first year
+		if len(calculation.SeasonalProfile) == 12 && calculation.AnnualFuelCost+calculation.AnnualElectricityCost > 0 {
+			createSection("Energiekosten im Jahresverlauf", seasonalPDFData(calculation), false, 0)
+		}
+
+		// Insurance premium per year of ownership
+		if calculation.Profile.InsuranceModel && len(calculation.InsurancePremiums) > 0 {
+			createSection("Versicherungsbeiträge", insurancePDFData(calculation), false, 0)
+		}
+
 		// Range information if applicable
 		var rangeData [][]string
 		if calculation.Profile.TankSize > 0 && calculation.Profile.FuelConsumption > 0 {
@@ -323,11 +579,37 @@ func (a *App) exportToPDF(calculation *models.CostCalculation) {
 		if calculation.Profile.BatterySize > 0 && calculation.Profile.ElectricConsumption > 0 {
 			electricRange := (calculation.Profile.BatterySize / calculation.Profile.ElectricConsumption) * 100
 			rangeData = append(rangeData, []string{translations.ElectricRange[:len(translations.ElectricRange)-2], FormatKilometers(electricRange)})
+			rangeData = append(rangeData, batteryHealthRows(calculation, FormatCurrencyPDF)...)
 		}
 		if len(rangeData) > 0 {
 			createSection(translations.ResultsRange, rangeData, false, 0)
 		}
 
+		// CO2 emissions if applicable
+		if calculation.MonthlyCO2 > 0 {
+			emissionsData := [][]string{
+				{"Monatliche Emissionen:", FormatCO2(calculation.MonthlyCO2)},
+				{"Jährliche Emissionen:", FormatCO2(calculation.AnnualCO2)},
+				{"Emissionen über Haltedauer:", FormatCO2(calculation.LifetimeCO2)},
+			}
+			createSection("CO2-Emissionen", emissionsData, false, 0)
+		}
+
+		// Sensitivity analysis with a tornado chart
+		variation := a.sensitivityVariation
+		if variation <= 0 {
+			variation = defaultSensitivityVariation
+		}
+		if analysis := a.calculator.CalculateSensitivity(calculation.Profile, variation); analysis != nil && len(analysis.Results) > 0 {
+			sensitivityData := [][]string{{"Gesamtkosten der Nutzung:", FormatCurrencyPDF(analysis.BaseTCO)}}
+			for _, result := range analysis.Results {
+				sensitivityData = append(sensitivityData, []string{result.Parameter + ":",
+					FormatCurrencyPDF(result.LowTCO) + " bis " + FormatCurrencyPDF(result.HighTCO)})
+			}
+			createSection(fmt.Sprintf("Sensitivitätsanalyse (± %s %%)", FormatGermanNumber(variation, 0)), sensitivityData, false, 0)
+			drawTornadoPDF(pdf, analysis)
+		}
+
 		// Footer
 		pdf.SetY(-20)
 		pdf.SetFont("Arial", "I", 8)
@@ -450,11 +732,36 @@ func (a *App) createComparisonTable(profiles []*models.CarProfile, calculations
 	rows := [][]string{
 		append([]string{"Monatliche Kraftstoffkosten"}, a.getCalculationValues(calculations, "monthly_fuel")...),
 		append([]string{"Monatliche Stromkosten"}, a.getCalculationValues(calculations, "monthly_electric")...),
+		append([]string{"Monatliche Wartung und Verschleiß"}, a.getCalculationValues(calculations, "monthly_maintenance")...),
+		append([]string{"Jährliche Gutschrift THG-Quote"}, a.getCalculationValues(calculations, "annual_revenue")...),
 		append([]string{"Monatliche Gesamtkosten"}, a.getCalculationValues(calculations, "monthly_total")...),
 		append([]string{"Jährliche Gesamtkosten"}, a.getCalculationValues(calculations, "annual_total")...),
 		append([]string{"Kosten pro Kilometer"}, a.getCalculationValues(calculations, "cost_per_km")...),
 		append([]string{"Jährlicher Wertverlust"}, a.getCalculationValues(calculations, "annual_depreciation")...),
 		append([]string{"Gesamtkosten der Nutzung"}, a.getCalculationValues(calculations, "total_ownership")...),
+		append([]string{"Barwert der Gesamtkosten"}, a.getCalculationValues(calculations, "present_ownership")...),
+		append([]string{"Äquivalente jährliche Kosten"}, a.getCalculationValues(calculations, "equivalent_annual")...),
+	}
+
+	for _, name := range customCostNames(calculations) {
+		rows = append(rows, append([]string{name}, a.getCustomCostValues(calculations, name)...))
+	}
+
+	rows = append(rows,
+		append([]string{"Jährliche CO2-Emissionen"}, a.getEmissionValues(calculations, "annual_co2")...),
+		append([]string{"CO2 über Haltedauer"}, a.getEmissionValues(calculations, "lifetime_co2")...),
+	)
+	if slices.ContainsFunc(profiles, func(profile *models.CarProfile) bool { return profile.CompanyCar }) {
+		// Monthly, annual and total costs of a company car above are the net
+		// salary reduction, the employer pays the car
+		rows = append(rows,
+			append([]string{"Geldwerter Vorteil pro Monat"}, a.getCompanyCarValues(calculations, "benefit")...),
+			append([]string{"Nettogehalt weniger pro Monat"}, a.getCompanyCarValues(calculations, "net_cost")...),
+		)
+	}
+	if len(profiles) > 1 {
+		rows = append(rows, append([]string{"CO2-Vermeidungskosten ggü. " + profiles[0].Name},
+			a.getCO2AvoidanceValues(calculations)...))
 	}
 
 	allRows := append([][]string{headers}, rows...)
@@ -495,22 +802,120 @@ func (a *App) getCalculationValues(calculations []*models.CostCalculation, value
 			value = calc.MonthlyFuelCost
 		case "monthly_electric":
 			value = calc.MonthlyElectricityCost
+		case "monthly_maintenance":
+			value = calc.MonthlyMaintenanceCost
+		case "annual_revenue":
+			value = calc.AnnualRevenue
 		case "monthly_total":
-			value = calc.MonthlyRunningCosts
+			value, _, _, _ = driverCosts(calc)
 		case "annual_total":
-			value = calc.AnnualRunningCosts
+			_, value, _, _ = driverCosts(calc)
 		case "cost_per_km":
-			value = calc.CostPerKilometer
+			_, _, _, value = driverCosts(calc)
 		case "annual_depreciation":
 			value = calc.AnnualDepreciation
 		case "total_ownership":
-			value = calc.TotalCostOfOwnership
+			_, _, value, _ = driverCosts(calc)
+		case "present_ownership":
+			value = calc.PresentCostOfOwnership
+		case "equivalent_annual":
+			value = calc.EquivalentAnnualCost
 		}
 		values = append(values, FormatCurrency(value))
 	}
 	return values
 }
 
+// getCompanyCarValues returns the company car values of each profile, "-"
+// for privately owned cars.
+func (a *App) getCompanyCarValues(calculations []*models.CostCalculation, valueType string) []string {
+	var values []string
+	for _, calc := range calculations {
+		if !calc.Profile.CompanyCar {
+			values = append(values, "-")
+			continue
+		}
+		var value float64
+		switch valueType {
+		case "benefit":
+			value = calc.CompanyCarBenefit
+		case "net_cost":
+			value = calc.MonthlyCompanyCarCost
+		}
+		values = append(values, FormatCurrency(value))
+	}
+	return values
+}
+
+func (a *App) getEmissionValues(calculations []*models.CostCalculation, valueType string) []string {
+	var values []string
+	for _, calc := range calculations {
+		var value float64
+		switch valueType {
+		case "annual_co2":
+			value = calc.AnnualCO2
+		case "lifetime_co2":
+			value = calc.LifetimeCO2
+		}
+		values = append(values, FormatCO2(value))
+	}
+	return values
+}
+
+// getCO2AvoidanceValues returns the cost per tonne of CO2 avoided by each
+// profile compared to the first one.
+func (a *App) getCO2AvoidanceValues(calculations []*models.CostCalculation) []string {
+	values := []string{"-"}
+	for _, calc := range calculations[1:] {
+		cost, ok := calculator.CO2AvoidanceCost(calc, calculations[0])
+		if !ok {
+			values = append(values, "keine Einsparung")
+			continue
+		}
+		values = append(values, FormatCurrency(cost)+"/t")
+	}
+	return values
+}
+
+// customCostNames returns the names of all custom cost items of the
+// compared profiles in order of appearance.
+func customCostNames(calculations []*models.CostCalculation) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, calc := range calculations {
+		for _, cost := range calc.CustomCostBreakdown {
+			if !seen[cost.Name] {
+				seen[cost.Name] = true
+				names = append(names, cost.Name)
+			}
+		}
+	}
+	return names
+}
+
+func (a *App) getCustomCostValues(calculations []*models.CostCalculation, name string) []string {
+	var values []string
+	for _, calc := range calculations {
+		var monthly, oneOff float64
+		for _, cost := range calc.CustomCostBreakdown {
+			if cost.Name == name {
+				monthly += cost.Monthly
+				oneOff += cost.OneOff
+			}
+		}
+
+		switch {
+		case oneOff > 0 && monthly > 0:
+			values = append(values, FormatCurrency(monthly)+"/Monat + "+FormatCurrency(oneOff)+" einmalig")
+		case oneOff > 0:
+			values = append(values, FormatCurrency(oneOff)+" einmalig")
+		default:
+			values = append(values, FormatCurrency(monthly)+"/Monat")
+		}
+	}
+	return values
+}
+
 func (a *App) createComparisonCharts(profiles []*models.CarProfile, calculations []*models.CostCalculation) *fyne.Container {
 	// Simplified chart representation using text
 	content := container.NewVBox()
@@ -532,11 +937,11 @@ func (a *App) createTextChart(profiles []*models.CarProfile, calculations []*mod
 		var value float64
 		switch chartType {
 		case "monthly":
-			value = calc.MonthlyRunningCosts
+			value, _, _, _ = driverCosts(calc)
 		case "annual":
-			value = calc.AnnualRunningCosts
+			_, value, _, _ = driverCosts(calc)
 		case "per_km":
-			value = calc.CostPerKilometer
+			_, _, _, value = driverCosts(calc)
 		}
 		values = append(values, value)
 		if value > maxValue {
@@ -585,8 +990,16 @@ func (a *App) showSettingsDialog() {
 		widget.NewFormItem(translations.SettingsDefaultFuel, fuelPriceEntry),
 		widget.NewFormItem(translations.SettingsDefaultElec, electricityPriceEntry),
 	)
+	assumptionItems, applyAssumptions := a.createAssumptionItems(&a.settings.Assumptions)
+	for _, item := range assumptionItems {
+		form.AppendItem(item)
+	}
+
+	// The assumptions make the form taller than the window
+	content := container.NewVScroll(form)
+	content.SetMinSize(fyne.NewSize(560, 480))
 
-	dialog.ShowCustomConfirm(translations.SettingsTitle, translations.SettingsSave, translations.SettingsCancel, form,
+	dialog.ShowCustomConfirm(translations.SettingsTitle, translations.SettingsSave, translations.SettingsCancel, content,
 		func(confirmed bool) {
 			if confirmed {
 				// Update theme
@@ -605,6 +1018,10 @@ func (a *App) showSettingsDialog() {
 					a.settings.Language = "de"
 				}
 
+				// Update calculation assumptions before the UI is recalculated
+				applyAssumptions()
+				a.calculator.SetAssumptions(a.settings.Assumptions)
+
 				// Update window title and UI
 				newTranslations := a.getCurrentTranslations()
 				a.window.SetTitle(newTranslations.AppTitle)
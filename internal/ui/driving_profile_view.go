@@ -0,0 +1,131 @@
+package ui
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+func (a *App) createDrivingProfileSection() *fyne.Container {
+	translations := a.getCurrentTranslations()
+
+	drivingProfileForm := widget.NewForm(
+		widget.NewFormItem(translations.FuelConsumptionCity, a.fuelCityEntry),
+		widget.NewFormItem(translations.FuelConsumptionRural, a.fuelRuralEntry),
+		widget.NewFormItem(translations.FuelConsumptionMotorway, a.fuelMotorwayEntry),
+		widget.NewFormItem(translations.ElectricConsumptionCity, a.electricCityEntry),
+		widget.NewFormItem(translations.ElectricConsumptionRural, a.electricRuralEntry),
+		widget.NewFormItem(translations.ElectricConsumptionMotorway, a.electricMotorwayEntry),
+		widget.NewFormItem(translations.CityShare, a.cityShareEntry),
+		widget.NewFormItem(translations.RuralShare, a.ruralShareEntry),
+		widget.NewFormItem(translations.MotorwayShare, a.motorwayShareEntry),
+	)
+
+	a.tripsBox = container.NewVBox()
+	addButton := widget.NewButtonWithIcon(translations.AddTrip, theme.ContentAddIcon(), func() {
+		if a.currentProfile == nil {
+			return
+		}
+		a.currentProfile.Trips = append(a.currentProfile.Trips, models.Trip{
+			Frequency: models.TripWorkday,
+			Count:     1,
+		})
+		a.updateTripRows()
+	})
+
+	header := container.NewGridWithColumns(7,
+		widget.NewLabelWithStyle(translations.TripName, fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		widget.NewLabelWithStyle(translations.TripDistance, fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		widget.NewLabelWithStyle(translations.TripFrequency, fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		widget.NewLabelWithStyle(translations.TripCount, fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		widget.NewLabelWithStyle(translations.CityShare, fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		widget.NewLabelWithStyle(translations.RuralShare, fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		widget.NewLabelWithStyle(translations.MotorwayShare, fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+	)
+
+	a.drivingProfileContent = container.NewVBox(
+		drivingProfileForm,
+		widget.NewLabelWithStyle(translations.TripsTitle, fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		header,
+		a.tripsBox,
+		addButton,
+	)
+	a.drivingProfileContent.Hide()
+
+	return container.NewVBox(
+		widget.NewCard(translations.DrivingProfileTitle, "", container.NewVBox(
+			a.drivingProfileCheck,
+			a.drivingProfileContent,
+		)),
+	)
+}
+
+// updateTripRows rebuilds the editable rows from the current profile.
+func (a *App) updateTripRows() {
+	if a.tripsBox == nil {
+		return
+	}
+
+	a.tripsBox.RemoveAll()
+	if a.currentProfile == nil {
+		return
+	}
+
+	for i := range a.currentProfile.Trips {
+		a.tripsBox.Add(a.createTripRow(i))
+	}
+	a.updateResults()
+}
+
+func (a *App) createTripRow(index int) fyne.CanvasObject {
+	trip := a.currentProfile.Trips[index]
+
+	nameEntry := widget.NewEntry()
+	nameEntry.SetPlaceHolder("z.B. Pendeln")
+	nameEntry.SetText(trip.Name)
+	nameEntry.OnChanged = func(text string) {
+		a.currentProfile.Trips[index].Name = text
+		a.updateResults()
+	}
+
+	// numberEntry returns an entry editing a number of the trip
+	numberEntry := func(placeholder string, value float64, set func(trip *models.Trip, value float64)) *widget.Entry {
+		entry := widget.NewEntry()
+		entry.SetPlaceHolder(placeholder)
+		entry.SetText(FormatGermanNumber(value, 0))
+		entry.OnChanged = func(text string) {
+			value, err := ParseGermanNumber(text)
+			if err != nil && text != "" {
+				return // Invalid number, skip update
+			}
+			set(&a.currentProfile.Trips[index], value)
+			a.updateResults()
+		}
+		return entry
+	}
+
+	distanceEntry := numberEntry("z.B. 40", trip.Distance, func(t *models.Trip, v float64) { t.Distance = v })
+	countEntry := numberEntry("1", trip.Count, func(t *models.Trip, v float64) { t.Count = v })
+	cityEntry := numberEntry("z.B. 30", trip.Mix.City, func(t *models.Trip, v float64) { t.Mix.City = v })
+	ruralEntry := numberEntry("z.B. 0", trip.Mix.Rural, func(t *models.Trip, v float64) { t.Mix.Rural = v })
+	motorwayEntry := numberEntry("z.B. 70", trip.Mix.Motorway, func(t *models.Trip, v float64) { t.Mix.Motorway = v })
+
+	frequencySelect := widget.NewSelect(a.getTranslatedTripFrequencies(), func(value string) {
+		a.currentProfile.Trips[index].Frequency = models.TripFrequency(a.getTripFrequencyFromTranslation(value))
+		a.updateResults()
+	})
+	frequencySelect.SetSelected(a.translateTripFrequency(string(trip.Frequency)))
+
+	deleteButton := widget.NewButtonWithIcon("", theme.DeleteIcon(), func() {
+		trips := a.currentProfile.Trips
+		a.currentProfile.Trips = append(trips[:index:index], trips[index+1:]...)
+		a.updateTripRows()
+	})
+
+	return container.NewBorder(nil, nil, nil, deleteButton,
+		container.NewGridWithColumns(7, nameEntry, distanceEntry, frequencySelect, countEntry,
+			cityEntry, ruralEntry, motorwayEntry))
+}
@@ -0,0 +1,144 @@
+package ui
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+	"encoding/csv"
+	"fmt"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/widget"
+)
+
+func (a *App) createLoanSummary(calculation *models.CostCalculation) *fyne.Container {
+	schedule := calculation.AmortizationSchedule
+	content := container.NewVBox(
+		widget.NewLabel("Kreditbetrag: "+FormatCurrency(calculation.LoanAmount)),
+		widget.NewLabel("Monatliche Rate: "+FormatCurrency(schedule[0].Payment)),
+		widget.NewLabel(fmt.Sprintf("Laufzeit: %d Monate", len(schedule))),
+		widget.NewLabel("Zinsen gesamt: "+FormatCurrency(calculation.TotalInterest)),
+	)
+
+	if calculation.Profile.BalloonPayment > 0 {
+		content.Add(widget.NewLabel("Schlussrate: " + FormatCurrency(calculation.Profile.BalloonPayment)))
+	}
+
+	if calculation.FinancingMonths > 0 && calculation.FinancingMonths < len(schedule) {
+		content.Add(widget.NewLabel(fmt.Sprintf("Ablösung bei Verkauf nach Monat %d: %s",
+			calculation.FinancingMonths, FormatCurrency(schedule[calculation.FinancingMonths-1].Balance))))
+	}
+
+	content.Add(widget.NewButton("Tilgungsplan anzeigen", func() {
+		a.showAmortizationSchedule(calculation)
+	}))
+
+	return content
+}
+
+func (a *App) createLeaseSummary(calculation *models.CostCalculation) *fyne.Container {
+	profile := calculation.Profile
+	content := container.NewVBox(
+		widget.NewLabel("Leasingsonderzahlung: "+FormatCurrency(profile.LeaseSpecialPayment)),
+		widget.NewLabel("Monatliche Leasingrate: "+FormatCurrency(profile.FinancingRate)),
+		widget.NewLabel(fmt.Sprintf("Laufzeit: %d Monate", calculation.FinancingMonths)),
+	)
+
+	if profile.LeaseAnnualKilometers > 0 {
+		content.Add(widget.NewLabel("Vertragskilometer pro Jahr: " + FormatKilometers(profile.LeaseAnnualKilometers)))
+		if calculation.LeaseExcessKilometers >= 0 {
+			content.Add(widget.NewLabel("Mehrkilometer: " + FormatKilometers(calculation.LeaseExcessKilometers)))
+		} else {
+			content.Add(widget.NewLabel("Minderkilometer: " + FormatKilometers(-calculation.LeaseExcessKilometers)))
+		}
+		content.Add(widget.NewLabel("Kilometerausgleich bei Vertragsende: " + FormatCurrency(calculation.LeaseSettlement)))
+	}
+
+	return content
+}
+
+func (a *App) showAmortizationSchedule(calculation *models.CostCalculation) {
+	scheduleWindow := a.fyneApp.NewWindow("Tilgungsplan - " + calculation.Profile.Name)
+	scheduleWindow.Resize(fyne.NewSize(800, 700))
+
+	headers := []string{"Monat", "Rate", "Zinsen", "Tilgung", "Restschuld"}
+	rows := amortizationRows(calculation.AmortizationSchedule, FormatCurrency)
+
+	table := widget.NewTable(
+		func() (int, int) {
+			return len(rows) + 1, len(headers)
+		},
+		func() fyne.CanvasObject {
+			return widget.NewLabel("Cell")
+		},
+		func(id widget.TableCellID, cell fyne.CanvasObject) {
+			label := cell.(*widget.Label)
+			if id.Row == 0 {
+				label.TextStyle.Bold = true
+				label.SetText(headers[id.Col])
+				return
+			}
+			label.TextStyle.Bold = false
+			label.SetText(rows[id.Row-1][id.Col])
+		},
+	)
+
+	table.SetColumnWidth(0, 80)
+	for i := 1; i < len(headers); i++ {
+		table.SetColumnWidth(i, 150)
+	}
+
+	exportButton := widget.NewButton("Als CSV exportieren", func() {
+		a.exportAmortizationToCSV(calculation)
+	})
+
+	summary := widget.NewLabel(fmt.Sprintf("Kreditbetrag: %s, Zinsen gesamt: %s",
+		FormatCurrency(calculation.LoanAmount), FormatCurrency(calculation.TotalInterest)))
+
+	scheduleWindow.SetContent(container.NewBorder(summary, exportButton, nil, nil, table))
+	scheduleWindow.Show()
+}
+
+func (a *App) exportAmortizationToCSV(calculation *models.CostCalculation) {
+	saveDialog := dialog.NewFileSave(func(writer fyne.URIWriteCloser, err error) {
+		if err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+		if writer == nil {
+			return
+		}
+		defer writer.Close()
+
+		csvWriter := csv.NewWriter(writer)
+		defer csvWriter.Flush()
+
+		csvWriter.Write([]string{"Monat", "Rate (€)", "Zinsen (€)", "Tilgung (€)", "Restschuld (€)"})
+		for _, row := range amortizationRows(calculation.AmortizationSchedule, func(value float64) string {
+			return FormatGermanNumber(value, 2)
+		}) {
+			csvWriter.Write(row)
+		}
+
+		dialog.ShowInformation("Export erfolgreich", "Der Tilgungsplan wurde erfolgreich exportiert.", a.window)
+	}, a.window)
+
+	saveDialog.SetFilter(storage.NewExtensionFileFilter([]string{".csv"}))
+	saveDialog.SetFileName("auto-unterhaltsrechner-tilgungsplan.csv")
+	saveDialog.Show()
+}
+
+func amortizationRows(schedule []models.LoanPayment, format func(float64) string) [][]string {
+	rows := make([][]string, 0, len(schedule))
+	for _, payment := range schedule {
+		rows = append(rows, []string{
+			fmt.Sprintf("%d", payment.Month),
+			format(payment.Payment),
+			format(payment.Interest),
+			format(payment.Principal),
+			format(payment.Balance),
+		})
+	}
+	return rows
+}
@@ -0,0 +1,133 @@
+package ui
+
+import (
+	"auto-unterhaltsrechner/internal/calculator"
+	"auto-unterhaltsrechner/internal/models"
+	"errors"
+	"fmt"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+)
+
+// goalSeekInputs are the solvable inputs with their label, unit and the
+// decimals shown
+var goalSeekInputs = []struct {
+	input    calculator.GoalSeekInput
+	label    string
+	unit     string
+	decimals int
+}{
+	{calculator.GoalSeekPurchasePrice, "Kaufpreis", "€", 0},
+	{calculator.GoalSeekAnnualKilometers, "Jahreskilometer", "km", 0},
+	{calculator.GoalSeekFuelPrice, "Kraftstoffpreis", "€/L", 3},
+	{calculator.GoalSeekElectricityPrice, "Strompreis", "€/kWh", 3},
+	{calculator.GoalSeekAnnualInsurance, "Versicherung pro Jahr", "€", 0},
+	{calculator.GoalSeekFinancingRate, "Finanzierungsrate", "€", 0},
+}
+
+var goalSeekMetricLabels = map[calculator.GoalSeekMetric]string{
+	calculator.GoalSeekMonthlyCost: "Durchschnittliche Monatskosten",
+	calculator.GoalSeekTotalCost:   "Gesamtkosten der Nutzung",
+	calculator.GoalSeekCostPerKm:   "Kosten pro Kilometer",
+}
+
+// noComparisonProfile means the target value is entered directly
+const noComparisonProfile = "Keins (Zielwert eingeben)"
+
+func (a *App) showGoalSeekDialog() {
+	if a.currentProfile == nil {
+		dialog.ShowInformation("Kein Profil", "Wählen Sie ein Profil aus oder erstellen Sie ein neues.", a.window)
+		return
+	}
+
+	var inputOptions []string
+	for _, entry := range goalSeekInputs {
+		inputOptions = append(inputOptions, entry.label)
+	}
+	var metricOptions []string
+	for _, metric := range calculator.GetGoalSeekMetrics() {
+		metricOptions = append(metricOptions, goalSeekMetricLabels[metric])
+	}
+
+	inputSelect := widget.NewSelect(inputOptions, nil)
+	inputSelect.SetSelectedIndex(0)
+	metricSelect := widget.NewSelect(metricOptions, nil)
+	metricSelect.SetSelectedIndex(0)
+
+	targetEntry := widget.NewEntry()
+	targetEntry.SetPlaceHolder("z.B. 500")
+
+	// Comparison profiles set the target to their own result, e.g. to find
+	// the electricity price at which an electric car costs as much
+	profileOptions := []string{noComparisonProfile}
+	profileMap := make(map[string]*models.CarProfile)
+	profiles, _ := a.storage.ListProfiles()
+	for _, profile := range profiles {
+		if profile.ID == a.currentProfile.ID {
+			continue
+		}
+		displayName := fmt.Sprintf("%s (%s)", profile.Name, profile.ID)
+		profileOptions = append(profileOptions, displayName)
+		profileMap[displayName] = profile
+	}
+	comparisonSelect := widget.NewSelect(profileOptions, func(value string) {
+		if value == noComparisonProfile {
+			targetEntry.Enable()
+		} else {
+			targetEntry.Disable()
+		}
+	})
+	comparisonSelect.SetSelectedIndex(0)
+
+	items := []*widget.FormItem{
+		widget.NewFormItem("Gesuchte Eingabe", inputSelect),
+		widget.NewFormItem("Kennzahl", metricSelect),
+		widget.NewFormItem("Zielwert", targetEntry),
+		widget.NewFormItem("Wie Vergleichsprofil", comparisonSelect),
+	}
+
+	goalSeekDialog := dialog.NewForm("Zielwertsuche", "Berechnen", "Abbrechen", items, func(confirmed bool) {
+		if !confirmed {
+			return
+		}
+
+		entry := goalSeekInputs[inputSelect.SelectedIndex()]
+		metric := calculator.GetGoalSeekMetrics()[metricSelect.SelectedIndex()]
+
+		var target float64
+		if comparison := profileMap[comparisonSelect.Selected]; comparison != nil {
+			target = calculator.GoalSeekValue(a.calculator.CalculateCosts(comparison), metric)
+		} else {
+			value, err := ParseGermanNumber(targetEntry.Text)
+			if err != nil {
+				dialog.ShowError(fmt.Errorf("ungültiger Zielwert: %s", targetEntry.Text), a.window)
+				return
+			}
+			target = value
+		}
+
+		value, err := a.calculator.GoalSeek(a.currentProfile, entry.input, metric, target)
+		if errors.Is(err, calculator.ErrGoalNotReachable) {
+			dialog.ShowInformation("Zielwertsuche", fmt.Sprintf("%s kann durch Ändern von %s nicht erreicht werden.",
+				goalSeekMetricLabels[metric], entry.label), a.window)
+			return
+		}
+		if err != nil {
+			dialog.ShowError(err, a.window)
+			return
+		}
+
+		// Write the solved value back into the form
+		entry.input.Apply(a.currentProfile, value)
+		a.updateInputForm()
+		a.updateResults()
+
+		dialog.ShowInformation("Zielwertsuche", fmt.Sprintf("%s: %s %s", entry.label,
+			FormatGermanNumber(value, entry.decimals), entry.unit), a.window)
+	}, a.window)
+
+	goalSeekDialog.Resize(fyne.NewSize(520, 320))
+	goalSeekDialog.Show()
+}
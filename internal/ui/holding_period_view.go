@@ -0,0 +1,94 @@
+package ui
+
+import (
+	"auto-unterhaltsrechner/internal/calculator"
+	"fmt"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+)
+
+func (a *App) createHoldingPeriodView() fyne.CanvasObject {
+	a.holdingPeriodChart = newLineChart()
+	a.holdingPeriodChart.FormatX = func(v float64) string { return FormatGermanNumber(v, 0) }
+	a.holdingPeriodChart.FormatY = func(v float64) string { return FormatGermanNumber(v, 0) + " €" }
+
+	a.holdingPeriodLabel = widget.NewLabel("")
+	a.holdingPeriodLabel.Wrapping = fyne.TextWrapWord
+	a.holdingPeriodHoverLabel = widget.NewLabel("Bewegen Sie die Maus über das Diagramm, um die Werte einer Haltedauer anzuzeigen.")
+
+	a.holdingPeriodButton = widget.NewButton("Empfohlene Haltedauer übernehmen", func() {
+		if a.currentProfile == nil || a.holdingPeriodOptimal <= 0 {
+			return
+		}
+		// Updates the profile and the results through OnChanged
+		a.ownershipYearsEntry.SetText(fmt.Sprintf("%d", a.holdingPeriodOptimal))
+	})
+	a.holdingPeriodButton.Disable()
+
+	return container.NewBorder(
+		widget.NewCard("Optimale Haltedauer", "Äquivalente jährliche Kosten bei Verkauf nach 1 bis 15 Jahren",
+			container.NewVBox(a.holdingPeriodLabel, a.holdingPeriodButton)),
+		a.holdingPeriodHoverLabel,
+		nil,
+		nil,
+		widget.NewCard("Kosten pro Jahr (€) nach Haltedauer in Jahren", "", a.holdingPeriodChart),
+	)
+}
+
+// updateHoldingPeriods recalculates the holding period analysis of the
+// current profile.
+func (a *App) updateHoldingPeriods() {
+	if a.holdingPeriodChart == nil {
+		return
+	}
+
+	a.holdingPeriodOptimal = 0
+	a.holdingPeriodButton.Disable()
+	if a.currentProfile == nil {
+		a.holdingPeriodLabel.SetText("")
+		a.holdingPeriodChart.SetData(nil, nil, math.NaN())
+		return
+	}
+
+	analysis := a.calculator.CalculateHoldingPeriods(a.currentProfile)
+	if analysis == nil || len(analysis.Periods) == 0 {
+		return
+	}
+
+	years := make([]float64, len(analysis.Periods))
+	annualCosts := make([]float64, len(analysis.Periods))
+	for i, period := range analysis.Periods {
+		years[i] = float64(period.Years)
+		annualCosts[i] = period.EquivalentAnnualCost
+	}
+
+	optimal := analysis.Periods[analysis.OptimalYears-1]
+	text := fmt.Sprintf("Empfohlene Haltedauer: %d Jahre mit %s pro Jahr (%s pro km).",
+		optimal.Years, FormatCurrency(optimal.EquivalentAnnualCost), FormatGermanNumber(optimal.CostPerKilometer, 3)+" €")
+	if current := a.currentProfile.ExpectedYearsOfOwnership; current >= 1 && current <= len(analysis.Periods) {
+		text += fmt.Sprintf(" Aktuell: %d Jahre mit %s pro Jahr.", current,
+			FormatCurrency(analysis.Periods[current-1].EquivalentAnnualCost))
+	}
+	a.holdingPeriodLabel.SetText(text)
+
+	a.holdingPeriodOptimal = analysis.OptimalYears
+	if analysis.OptimalYears != a.currentProfile.ExpectedYearsOfOwnership {
+		a.holdingPeriodButton.Enable()
+	}
+
+	a.holdingPeriodChart.OnHover = func(index int) {
+		a.holdingPeriodHoverLabel.SetText(formatHoldingPeriod(analysis.Periods[index]))
+	}
+	a.holdingPeriodChart.SetData(years, []chartSeries{
+		{Name: "Äquivalente jährliche Kosten", Color: chartColorCombustion, Values: annualCosts},
+	}, float64(analysis.OptimalYears))
+}
+
+func formatHoldingPeriod(period calculator.HoldingPeriod) string {
+	return fmt.Sprintf("%d Jahre: %s pro Jahr, Gesamtkosten %s, Restwert %s",
+		period.Years, FormatCurrency(period.EquivalentAnnualCost),
+		FormatCurrency(period.TotalCostOfOwnership), FormatCurrency(period.ResidualValue))
+}
@@ -14,33 +14,114 @@ type Translations struct {
 	MenuSettings   string
 
 	// Profile section
-	ProfileTitle      string
-	ProfileSelect     string
-	ProfileName       string
-	ConsumptionTitle  string
-	PricesTitle       string
-	CapacityTitle     string
-	UsageTitle        string
-	CostsTitle        string
-	FinancingTitle    string
-	DepreciationTitle string
+	ProfileTitle        string
+	ProfileSelect       string
+	ProfileName         string
+	ConsumptionTitle    string
+	PricesTitle         string
+	CapacityTitle       string
+	UsageTitle          string
+	CostsTitle          string
+	FinancingTitle      string
+	ChargingMixTitle    string
+	MaintenanceTitle    string
+	CustomCostsTitle    string
+	CreditsTitle        string
+	DepreciationTitle   string
+	CompanyCarTitle     string
+	InsuranceTitle      string
+	DrivingProfileTitle string
 
 	// Input fields
-	FuelConsumption     string
-	ElectricConsumption string
-	FuelPrice           string
-	ElectricityPrice    string
-	FuelType            string
-	ElectricityType     string
-	TankSize            string
-	BatterySize         string
-	MonthlyKilometers   string
-	AnnualTax           string
-	AnnualInsurance     string
-	FinancingRate       string
-	FinancingPeriod     string
-	PurchasePrice       string
-	OwnershipYears      string
+	FuelConsumption             string
+	ElectricConsumption         string
+	FuelPrice                   string
+	ElectricityPrice            string
+	FuelType                    string
+	ElectricityType             string
+	TankSize                    string
+	BatterySize                 string
+	BatteryAgingPerYear         string
+	BatteryAgingPer100k         string
+	BatteryReplacementCost      string
+	BatteryReplacementHealth    string
+	MonthlyKilometers           string
+	ElectricShare               string
+	ChargingSourceType          string
+	ChargingSourcePrice         string
+	ChargingSourceShare         string
+	ChargingEfficiency          string
+	AddChargingSource           string
+	DailyCommuteKm              string
+	SeasonalFactors             string
+	AnnualTax                   string
+	AutoCarTax                  string
+	FirstRegistration           string
+	EngineDisplacement          string
+	CO2Emissions                string
+	VehicleWeight               string
+	AnnualInsurance             string
+	AcquisitionType             string
+	FinancingRate               string
+	FinancingPeriod             string
+	DownPayment                 string
+	InterestRate                string
+	BalloonPayment              string
+	LeaseSpecialPayment         string
+	LeaseAnnualKilometers       string
+	LeaseExcessKmCost           string
+	LeaseUnusedKmCredit         string
+	DepreciationModel           string
+	DepreciationRate            string
+	ExpectedResaleValue         string
+	AnnualTHGRevenue            string
+	PurchaseIncentives          string
+	CompanyCar                  string
+	CompanyCarMethod            string
+	CommuteDistance             string
+	PrivateUseShare             string
+	PersonalTaxRate             string
+	ServiceCost                 string
+	ServiceIntervalMonths       string
+	ServiceIntervalKm           string
+	InspectionCost              string
+	InspectionInterval          string
+	TireCost                    string
+	TireLifetimeKm              string
+	BrakeCost                   string
+	BrakeIntervalKm             string
+	AnnualRepairReserve         string
+	CustomCostName              string
+	CustomCostAmount            string
+	CustomCostRecurrence        string
+	AddCustomCost               string
+	PurchasePrice               string
+	OdometerAtPurchase          string
+	OdometerAtSale              string
+	OwnershipYears              string
+	DrivingProfile              string
+	InsuranceModel              string
+	InsuranceCoverage           string
+	InsuranceSFClass            string
+	InsuranceLiabilityPremium   string
+	InsurancePartialPremium     string
+	InsuranceFullPremium        string
+	InsuranceSwitchYears        string
+	FuelConsumptionCity         string
+	FuelConsumptionRural        string
+	FuelConsumptionMotorway     string
+	ElectricConsumptionCity     string
+	ElectricConsumptionRural    string
+	ElectricConsumptionMotorway string
+	CityShare                   string
+	RuralShare                  string
+	MotorwayShare               string
+	TripsTitle                  string
+	TripName                    string
+	TripDistance                string
+	TripFrequency               string
+	TripCount                   string
+	AddTrip                     string
 
 	// Fuel types
 	FuelTypeDiesel         string
@@ -50,8 +131,51 @@ type Translations struct {
 	FuelTypeUltimateDiesel string
 
 	// Electricity types
-	ElectricityTypeHome   string
-	ElectricityTypePublic string
+	ElectricityTypeHome      string
+	ElectricityTypePublic    string
+	ElectricityTypeWallbox   string
+	ElectricityTypeWorkplace string
+	ElectricityTypeFast      string
+
+	// Depreciation models
+	DepreciationLinear           string
+	DepreciationDecliningBalance string
+	DepreciationAgeMileage       string
+	DepreciationResaleValue      string
+
+	// Company car methods
+	CompanyCarFlatRate       string
+	CompanyCarOnePercent     string
+	CompanyCarHalfPercent    string
+	CompanyCarQuarterPercent string
+	CompanyCarLogbook        string
+
+	// Insurance coverages
+	CoverageLiability     string
+	CoveragePartial       string
+	CoverageComprehensive string
+
+	// Cost recurrences
+	RecurrenceOnce    string
+	RecurrenceMonthly string
+	RecurrenceAnnual  string
+	RecurrencePerKm   string
+
+	// Probability distributions
+	DistributionNormal     string
+	DistributionUniform    string
+	DistributionTriangular string
+
+	// Trip frequencies
+	TripWorkday string
+	TripWeekly  string
+	TripMonthly string
+	TripAnnual  string
+
+	// Acquisition types
+	AcquisitionTypeCash  string
+	AcquisitionTypeLoan  string
+	AcquisitionTypeLease string
 
 	// Results sections
 	ResultsMonthlyCosts string
@@ -80,13 +204,24 @@ type Translations struct {
 	ElectricRange         string
 
 	// Settings
-	SettingsTitle       string
-	SettingsTheme       string
-	SettingsLanguage    string
-	SettingsDefaultFuel string
-	SettingsDefaultElec string
-	SettingsSave        string
-	SettingsCancel      string
+	SettingsTitle                 string
+	SettingsTheme                 string
+	SettingsLanguage              string
+	SettingsDefaultFuel           string
+	SettingsDefaultElec           string
+	SettingsSave                  string
+	SettingsCancel                string
+	SettingsFuelCO2               string
+	SettingsGridCO2               string
+	SettingsFuelEscalation        string
+	SettingsElecEscalation        string
+	SettingsInsuranceEscalation   string
+	SettingsTaxEscalation         string
+	SettingsMaintenanceEscalation string
+	SettingsMaintenanceAging      string
+	SettingsCO2Prices             string
+	SettingsDiscountRate          string
+	SettingsIncludeCapitalCost    string
 
 	// Theme options
 	ThemeLight string
@@ -158,32 +293,113 @@ var GermanTranslations = Translations{
 	MenuComparison: "Vergleich",
 	MenuSettings:   "Einstellungen",
 
-	ProfileTitle:      "Profil",
-	ProfileSelect:     "Profil auswählen",
-	ProfileName:       "Profilname",
-	ConsumptionTitle:  "Verbrauch",
-	PricesTitle:       "Preise",
-	CapacityTitle:     "Kapazitäten",
-	UsageTitle:        "Nutzung",
-	CostsTitle:        "Fixkosten",
-	FinancingTitle:    "Finanzierung",
-	DepreciationTitle: "Wertverlust",
-
-	FuelConsumption:     "Kraftstoffverbrauch (L/100km)",
-	ElectricConsumption: "Stromverbrauch (kWh/100km)",
-	FuelPrice:           "Kraftstoffpreis (€/L)",
-	ElectricityPrice:    "Strompreis (€/kWh)",
-	FuelType:            "Kraftstoffart",
-	ElectricityType:     "Stromart",
-	TankSize:            "Tankgröße (L)",
-	BatterySize:         "Batteriegröße (kWh)",
-	MonthlyKilometers:   "Monatliche Kilometer",
-	AnnualTax:           "Jährliche KFZ-Steuer (€)",
-	AnnualInsurance:     "Jährliche Versicherung (€)",
-	FinancingRate:       "Finanzierungsrate (€/Monat)",
-	FinancingPeriod:     "Finanzierungslaufzeit (Monate)",
-	PurchasePrice:       "Kaufpreis (€)",
-	OwnershipYears:      "Erwartete Besitzdauer (Jahre)",
+	ProfileTitle:        "Profil",
+	ProfileSelect:       "Profil auswählen",
+	ProfileName:         "Profilname",
+	ConsumptionTitle:    "Verbrauch",
+	PricesTitle:         "Preise",
+	CapacityTitle:       "Kapazitäten",
+	UsageTitle:          "Nutzung",
+	CostsTitle:          "Fixkosten",
+	FinancingTitle:      "Finanzierung",
+	ChargingMixTitle:    "Lademix",
+	MaintenanceTitle:    "Wartung und Verschleiß",
+	CustomCostsTitle:    "Weitere Kosten",
+	CreditsTitle:        "Einnahmen und Förderungen",
+	DepreciationTitle:   "Wertverlust",
+	CompanyCarTitle:     "Dienstwagen",
+	InsuranceTitle:      "Versicherung nach SF-Klasse",
+	DrivingProfileTitle: "Fahrprofil",
+
+	FuelConsumption:             "Kraftstoffverbrauch (L/100km)",
+	ElectricConsumption:         "Stromverbrauch (kWh/100km)",
+	FuelPrice:                   "Kraftstoffpreis (€/L)",
+	ElectricityPrice:            "Strompreis (€/kWh)",
+	FuelType:                    "Kraftstoffart",
+	ElectricityType:             "Stromart",
+	TankSize:                    "Tankgröße (L)",
+	BatterySize:                 "Batteriegröße (kWh)",
+	BatteryAgingPerYear:         "Batteriealterung pro Jahr (%)",
+	BatteryAgingPer100k:         "Batteriealterung pro 100.000 km (%)",
+	BatteryReplacementCost:      "Kosten Batterietausch (€)",
+	BatteryReplacementHealth:    "Batterietausch unter Zustand (%)",
+	MonthlyKilometers:           "Monatliche Kilometer",
+	ElectricShare:               "Elektrischer Fahranteil (%, Plug-in-Hybrid)",
+	ChargingSourceType:          "Ladeart",
+	ChargingSourcePrice:         "Preis (€/kWh)",
+	ChargingSourceShare:         "Anteil (%)",
+	ChargingEfficiency:          "Ladewirkungsgrad (%)",
+	AddChargingSource:           "Ladeart hinzufügen",
+	DailyCommuteKm:              "Tägliche Pendelstrecke (km)",
+	SeasonalFactors:             "Verbrauch je Monat Jan-Dez (%)",
+	AnnualTax:                   "Jährliche KFZ-Steuer (€)",
+	AutoCarTax:                  "KFZ-Steuer automatisch berechnen",
+	FirstRegistration:           "Erstzulassung (TT.MM.JJJJ)",
+	EngineDisplacement:          "Hubraum (ccm)",
+	CO2Emissions:                "CO2-Ausstoß WLTP (g/km)",
+	VehicleWeight:               "Zulässiges Gesamtgewicht (kg)",
+	AnnualInsurance:             "Jährliche Versicherung (€)",
+	AcquisitionType:             "Erwerbsart",
+	FinancingRate:               "Finanzierungs-/Leasingrate (€/Monat)",
+	FinancingPeriod:             "Finanzierungslaufzeit (Monate)",
+	DownPayment:                 "Anzahlung (€)",
+	InterestRate:                "Effektivzins (% p.a.)",
+	BalloonPayment:              "Schlussrate (€)",
+	LeaseSpecialPayment:         "Leasingsonderzahlung (€)",
+	LeaseAnnualKilometers:       "Vertragskilometer pro Jahr",
+	LeaseExcessKmCost:           "Mehrkilometer (€/km)",
+	LeaseUnusedKmCredit:         "Minderkilometer (€/km)",
+	PurchasePrice:               "Kaufpreis (€)",
+	OdometerAtPurchase:          "Kilometerstand bei Kauf (km)",
+	OdometerAtSale:              "Erwarteter Kilometerstand bei Verkauf (km)",
+	DepreciationModel:           "Wertverlustmodell",
+	DepreciationRate:            "Wertverlust pro Jahr (%, degressiv)",
+	ExpectedResaleValue:         "Erwarteter Wiederverkaufswert (€)",
+	AnnualTHGRevenue:            "THG-Quote pro Jahr (€)",
+	PurchaseIncentives:          "Kaufprämien und Händlerboni (€)",
+	CompanyCar:                  "Dienstwagen mit privater Nutzung (Kaufpreis = Bruttolistenpreis)",
+	CompanyCarMethod:            "Besteuerung",
+	CommuteDistance:             "Entfernung Wohnung - Arbeitsstätte (km)",
+	PrivateUseShare:             "Privatanteil laut Fahrtenbuch (%)",
+	PersonalTaxRate:             "Persönlicher Grenzsteuersatz (%)",
+	ServiceCost:                 "Inspektion (€)",
+	ServiceIntervalMonths:       "Inspektionsintervall (Monate)",
+	ServiceIntervalKm:           "Inspektionsintervall (km)",
+	InspectionCost:              "HU/AU (€)",
+	InspectionInterval:          "HU/AU-Intervall (Monate)",
+	TireCost:                    "Reifensatz inkl. Montage (€)",
+	TireLifetimeKm:              "Reifenlaufleistung (km)",
+	BrakeCost:                   "Bremsen (€)",
+	BrakeIntervalKm:             "Bremsenintervall (km)",
+	AnnualRepairReserve:         "Reparaturrücklage pro Jahr (€)",
+	CustomCostName:              "Bezeichnung",
+	CustomCostAmount:            "Betrag (€ bzw. €/km)",
+	CustomCostRecurrence:        "Wiederholung",
+	AddCustomCost:               "Kosten hinzufügen",
+	OwnershipYears:              "Erwartete Besitzdauer (Jahre)",
+	DrivingProfile:              "Verbrauch und Fahrleistung nach Straßentyp berechnen",
+	InsuranceModel:              "Versicherung nach SF-Klasse und Deckung berechnen",
+	InsuranceCoverage:           "Deckung",
+	InsuranceSFClass:            "SF-Klasse bei Kauf",
+	InsuranceLiabilityPremium:   "Beitrag Haftpflicht (€/Jahr)",
+	InsurancePartialPremium:     "Aufpreis Teilkasko (€/Jahr)",
+	InsuranceFullPremium:        "Aufpreis Vollkasko (€/Jahr)",
+	InsuranceSwitchYears:        "Wechsel zur Teilkasko nach (Jahren)",
+	FuelConsumptionCity:         "Kraftstoffverbrauch Stadt (L/100km)",
+	FuelConsumptionRural:        "Kraftstoffverbrauch Landstraße (L/100km)",
+	FuelConsumptionMotorway:     "Kraftstoffverbrauch Autobahn (L/100km)",
+	ElectricConsumptionCity:     "Stromverbrauch Stadt (kWh/100km)",
+	ElectricConsumptionRural:    "Stromverbrauch Landstraße (kWh/100km)",
+	ElectricConsumptionMotorway: "Stromverbrauch Autobahn (kWh/100km)",
+	CityShare:                   "Anteil Stadt (%)",
+	RuralShare:                  "Anteil Landstraße (%)",
+	MotorwayShare:               "Anteil Autobahn (%)",
+	TripsTitle:                  "Regelmäßige Fahrten (ersetzen Anteile und monatliche Kilometer)",
+	TripName:                    "Bezeichnung",
+	TripDistance:                "Strecke hin und zurück (km)",
+	TripFrequency:               "Häufigkeit",
+	TripCount:                   "Anzahl",
+	AddTrip:                     "Fahrt hinzufügen",
 
 	FuelTypeDiesel:         "Diesel",
 	FuelTypeUltimate:       "Ultimate",
@@ -191,8 +407,44 @@ var GermanTranslations = Translations{
 	FuelTypeSuperPlus:      "Super Plus",
 	FuelTypeUltimateDiesel: "Ultimate Diesel",
 
-	ElectricityTypeHome:   "Haushaltsstrom",
-	ElectricityTypePublic: "Öffentliche Ladestation",
+	ElectricityTypeHome:      "Haushaltsstrom",
+	ElectricityTypePublic:    "Öffentliche Ladestation",
+	ElectricityTypeWallbox:   "Wallbox zu Hause",
+	ElectricityTypeWorkplace: "Arbeitgeber",
+	ElectricityTypeFast:      "DC-Schnelllader",
+
+	DepreciationLinear:           "Linear",
+	DepreciationDecliningBalance: "Degressiv",
+	DepreciationAgeMileage:       "Alter und Laufleistung",
+	DepreciationResaleValue:      "Wiederverkaufswert",
+
+	CompanyCarFlatRate:       "Pauschal nach Antrieb",
+	CompanyCarOnePercent:     "1%-Regelung",
+	CompanyCarHalfPercent:    "0,5%-Regelung",
+	CompanyCarQuarterPercent: "0,25%-Regelung",
+	CompanyCarLogbook:        "Fahrtenbuch",
+
+	CoverageLiability:     "Haftpflicht",
+	CoveragePartial:       "Teilkasko",
+	CoverageComprehensive: "Vollkasko",
+
+	RecurrenceOnce:    "Einmalig",
+	RecurrenceMonthly: "Monatlich",
+	RecurrenceAnnual:  "Jährlich",
+	RecurrencePerKm:   "Pro km",
+
+	DistributionNormal:     "Normalverteilung",
+	DistributionUniform:    "Gleichverteilung",
+	DistributionTriangular: "Dreiecksverteilung",
+
+	TripWorkday: "Arbeitstäglich",
+	TripWeekly:  "Wöchentlich",
+	TripMonthly: "Monatlich",
+	TripAnnual:  "Jährlich",
+
+	AcquisitionTypeCash:  "Barkauf",
+	AcquisitionTypeLoan:  "Kredit",
+	AcquisitionTypeLease: "Leasing",
 
 	ResultsMonthlyCosts: "Monatliche Kosten",
 	ResultsAnnualCosts:  "Jährliche Kosten",
@@ -218,13 +470,24 @@ var GermanTranslations = Translations{
 	FuelRange:             "Reichweite mit vollem Tank: ",
 	ElectricRange:         "Elektrische Reichweite: ",
 
-	SettingsTitle:       "Einstellungen",
-	SettingsTheme:       "Design",
-	SettingsLanguage:    "Sprache",
-	SettingsDefaultFuel: "Standard Kraftstoffpreis (€/L)",
-	SettingsDefaultElec: "Standard Strompreis (€/kWh)",
-	SettingsSave:        "Speichern",
-	SettingsCancel:      "Abbrechen",
+	SettingsTitle:                 "Einstellungen",
+	SettingsTheme:                 "Design",
+	SettingsLanguage:              "Sprache",
+	SettingsDefaultFuel:           "Standard Kraftstoffpreis (€/L)",
+	SettingsDefaultElec:           "Standard Strompreis (€/kWh)",
+	SettingsSave:                  "Speichern",
+	SettingsCancel:                "Abbrechen",
+	SettingsFuelCO2:               "CO2 %s (kg/L)",
+	SettingsGridCO2:               "CO2 %s (g/kWh)",
+	SettingsFuelEscalation:        "Preissteigerung Kraftstoff (%/Jahr)",
+	SettingsElecEscalation:        "Preissteigerung Strom (%/Jahr)",
+	SettingsInsuranceEscalation:   "Preissteigerung Versicherung (%/Jahr)",
+	SettingsTaxEscalation:         "Steigerung KFZ-Steuer (%/Jahr)",
+	SettingsMaintenanceEscalation: "Preissteigerung Wartung (%/Jahr)",
+	SettingsMaintenanceAging:      "Mehrkosten Wartung mit Fahrzeugalter (%/Jahr)",
+	SettingsCO2Prices:             "CO2-Preispfad (Jahr: €/t; ...)",
+	SettingsDiscountRate:          "Kalkulationszins (%/Jahr)",
+	SettingsIncludeCapitalCost:    "Kapitalkosten in Gesamtkosten einrechnen",
 
 	ThemeLight: "Hell",
 	ThemeDark:  "Dunkel",
@@ -289,32 +552,113 @@ var EnglishTranslations = Translations{
 	MenuComparison: "Comparison",
 	MenuSettings:   "Settings",
 
-	ProfileTitle:      "Profile",
-	ProfileSelect:     "Select Profile",
-	ProfileName:       "Profile Name",
-	ConsumptionTitle:  "Consumption",
-	PricesTitle:       "Prices",
-	CapacityTitle:     "Capacities",
-	UsageTitle:        "Usage",
-	CostsTitle:        "Fixed Costs",
-	FinancingTitle:    "Financing",
-	DepreciationTitle: "Depreciation",
-
-	FuelConsumption:     "Fuel Consumption (L/100km)",
-	ElectricConsumption: "Electric Consumption (kWh/100km)",
-	FuelPrice:           "Fuel Price (€/L)",
-	ElectricityPrice:    "Electricity Price (€/kWh)",
-	FuelType:            "Fuel Type",
-	ElectricityType:     "Electricity Type",
-	TankSize:            "Tank Size (L)",
-	BatterySize:         "Battery Size (kWh)",
-	MonthlyKilometers:   "Monthly Kilometers",
-	AnnualTax:           "Annual Vehicle Tax (€)",
-	AnnualInsurance:     "Annual Insurance (€)",
-	FinancingRate:       "Financing Rate (€/Month)",
-	FinancingPeriod:     "Financing Period (Months)",
-	PurchasePrice:       "Purchase Price (€)",
-	OwnershipYears:      "Expected Ownership Years",
+	ProfileTitle:        "Profile",
+	ProfileSelect:       "Select Profile",
+	ProfileName:         "Profile Name",
+	ConsumptionTitle:    "Consumption",
+	PricesTitle:         "Prices",
+	CapacityTitle:       "Capacities",
+	UsageTitle:          "Usage",
+	CostsTitle:          "Fixed Costs",
+	FinancingTitle:      "Financing",
+	ChargingMixTitle:    "Charging Mix",
+	MaintenanceTitle:    "Maintenance and Wear",
+	CustomCostsTitle:    "Other Costs",
+	CreditsTitle:        "Revenues and Subsidies",
+	DepreciationTitle:   "Depreciation",
+	CompanyCarTitle:     "Company Car",
+	InsuranceTitle:      "Insurance by No-Claims Class",
+	DrivingProfileTitle: "Driving Profile",
+
+	FuelConsumption:             "Fuel Consumption (L/100km)",
+	ElectricConsumption:         "Electric Consumption (kWh/100km)",
+	FuelPrice:                   "Fuel Price (€/L)",
+	ElectricityPrice:            "Electricity Price (€/kWh)",
+	FuelType:                    "Fuel Type",
+	ElectricityType:             "Electricity Type",
+	TankSize:                    "Tank Size (L)",
+	BatterySize:                 "Battery Size (kWh)",
+	BatteryAgingPerYear:         "Battery Aging per Year (%)",
+	BatteryAgingPer100k:         "Battery Aging per 100,000 km (%)",
+	BatteryReplacementCost:      "Battery Replacement Cost (€)",
+	BatteryReplacementHealth:    "Replace Battery below Health (%)",
+	MonthlyKilometers:           "Monthly Kilometers",
+	ElectricShare:               "Electric Driving Share (%, Plug-in Hybrid)",
+	ChargingSourceType:          "Charging Type",
+	ChargingSourcePrice:         "Price (€/kWh)",
+	ChargingSourceShare:         "Share (%)",
+	ChargingEfficiency:          "Charging Efficiency (%)",
+	AddChargingSource:           "Add Charging Type",
+	DailyCommuteKm:              "Daily Commute (km)",
+	SeasonalFactors:             "Consumption per Month Jan-Dec (%)",
+	AnnualTax:                   "Annual Vehicle Tax (€)",
+	AutoCarTax:                  "Calculate vehicle tax automatically",
+	FirstRegistration:           "First Registration (DD.MM.YYYY)",
+	EngineDisplacement:          "Engine Displacement (ccm)",
+	CO2Emissions:                "CO2 Emissions WLTP (g/km)",
+	VehicleWeight:               "Permissible Total Weight (kg)",
+	AnnualInsurance:             "Annual Insurance (€)",
+	AcquisitionType:             "Acquisition Type",
+	FinancingRate:               "Financing/Lease Rate (€/Month)",
+	FinancingPeriod:             "Financing Period (Months)",
+	DownPayment:                 "Down Payment (€)",
+	InterestRate:                "Effective Interest Rate (% p.a.)",
+	BalloonPayment:              "Balloon Payment (€)",
+	LeaseSpecialPayment:         "Lease Down Payment (€)",
+	LeaseAnnualKilometers:       "Contracted Kilometers per Year",
+	LeaseExcessKmCost:           "Excess Kilometers (€/km)",
+	LeaseUnusedKmCredit:         "Unused Kilometers (€/km)",
+	PurchasePrice:               "Purchase Price (€)",
+	OdometerAtPurchase:          "Odometer at Purchase (km)",
+	OdometerAtSale:              "Expected Odometer at Sale (km)",
+	DepreciationModel:           "Depreciation Model",
+	DepreciationRate:            "Depreciation per Year (%, declining)",
+	ExpectedResaleValue:         "Expected Resale Value (€)",
+	AnnualTHGRevenue:            "GHG Quota per Year (€)",
+	PurchaseIncentives:          "Purchase Subsidies and Dealer Bonuses (€)",
+	CompanyCar:                  "Company car with private use (purchase price = gross list price)",
+	CompanyCarMethod:            "Taxation",
+	CommuteDistance:             "Distance Home - Work (km)",
+	PrivateUseShare:             "Private Share per Logbook (%)",
+	PersonalTaxRate:             "Personal Marginal Tax Rate (%)",
+	ServiceCost:                 "Service (€)",
+	ServiceIntervalMonths:       "Service Interval (Months)",
+	ServiceIntervalKm:           "Service Interval (km)",
+	InspectionCost:              "Roadworthiness Test (€)",
+	InspectionInterval:          "Roadworthiness Test Interval (Months)",
+	TireCost:                    "Set of Tires incl. Fitting (€)",
+	TireLifetimeKm:              "Tire Lifetime (km)",
+	BrakeCost:                   "Brakes (€)",
+	BrakeIntervalKm:             "Brake Interval (km)",
+	AnnualRepairReserve:         "Repair Reserve per Year (€)",
+	CustomCostName:              "Description",
+	CustomCostAmount:            "Amount (€ or €/km)",
+	CustomCostRecurrence:        "Recurrence",
+	AddCustomCost:               "Add Cost",
+	OwnershipYears:              "Expected Ownership Years",
+	DrivingProfile:              "Calculate consumption and mileage by road type",
+	InsuranceModel:              "Calculate insurance by no-claims class and coverage",
+	InsuranceCoverage:           "Coverage",
+	InsuranceSFClass:            "No-Claims Class at Purchase",
+	InsuranceLiabilityPremium:   "Liability Premium (€/year)",
+	InsurancePartialPremium:     "Partial Cover Surcharge (€/year)",
+	InsuranceFullPremium:        "Comprehensive Surcharge (€/year)",
+	InsuranceSwitchYears:        "Switch to Partial Cover after (years)",
+	FuelConsumptionCity:         "Fuel Consumption City (L/100km)",
+	FuelConsumptionRural:        "Fuel Consumption Rural (L/100km)",
+	FuelConsumptionMotorway:     "Fuel Consumption Motorway (L/100km)",
+	ElectricConsumptionCity:     "Electric Consumption City (kWh/100km)",
+	ElectricConsumptionRural:    "Electric Consumption Rural (kWh/100km)",
+	ElectricConsumptionMotorway: "Electric Consumption Motorway (kWh/100km)",
+	CityShare:                   "City Share (%)",
+	RuralShare:                  "Rural Share (%)",
+	MotorwayShare:               "Motorway Share (%)",
+	TripsTitle:                  "Recurring Trips (replace shares and monthly kilometers)",
+	TripName:                    "Description",
+	TripDistance:                "Round Trip (km)",
+	TripFrequency:               "Frequency",
+	TripCount:                   "Count",
+	AddTrip:                     "Add Trip",
 
 	FuelTypeDiesel:         "Diesel",
 	FuelTypeUltimate:       "Ultimate",
@@ -322,8 +666,44 @@ var EnglishTranslations = Translations{
 	FuelTypeSuperPlus:      "Super Plus",
 	FuelTypeUltimateDiesel: "Ultimate Diesel",
 
-	ElectricityTypeHome:   "Home Electricity",
-	ElectricityTypePublic: "Public Charging Station",
+	ElectricityTypeHome:      "Home Electricity",
+	ElectricityTypePublic:    "Public Charging Station",
+	ElectricityTypeWallbox:   "Home Wallbox",
+	ElectricityTypeWorkplace: "Workplace",
+	ElectricityTypeFast:      "DC Fast Charger",
+
+	DepreciationLinear:           "Linear",
+	DepreciationDecliningBalance: "Declining Balance",
+	DepreciationAgeMileage:       "Age and Mileage",
+	DepreciationResaleValue:      "Resale Value",
+
+	CompanyCarFlatRate:       "Flat Rate by Powertrain",
+	CompanyCarOnePercent:     "1% Rule",
+	CompanyCarHalfPercent:    "0.5% Rule",
+	CompanyCarQuarterPercent: "0.25% Rule",
+	CompanyCarLogbook:        "Logbook",
+
+	CoverageLiability:     "Liability",
+	CoveragePartial:       "Partial Cover",
+	CoverageComprehensive: "Comprehensive",
+
+	RecurrenceOnce:    "One-off",
+	RecurrenceMonthly: "Monthly",
+	RecurrenceAnnual:  "Annual",
+	RecurrencePerKm:   "Per km",
+
+	DistributionNormal:     "Normal",
+	DistributionUniform:    "Uniform",
+	DistributionTriangular: "Triangular",
+
+	TripWorkday: "Every Workday",
+	TripWeekly:  "Weekly",
+	TripMonthly: "Monthly",
+	TripAnnual:  "Annual",
+
+	AcquisitionTypeCash:  "Cash Purchase",
+	AcquisitionTypeLoan:  "Loan",
+	AcquisitionTypeLease: "Lease",
 
 	ResultsMonthlyCosts: "Monthly Costs",
 	ResultsAnnualCosts:  "Annual Costs",
@@ -349,13 +729,24 @@ var EnglishTranslations = Translations{
 	FuelRange:             "Range with full tank: ",
 	ElectricRange:         "Electric range: ",
 
-	SettingsTitle:       "Settings",
-	SettingsTheme:       "Theme",
-	SettingsLanguage:    "Language",
-	SettingsDefaultFuel: "Default Fuel Price (€/L)",
-	SettingsDefaultElec: "Default Electricity Price (€/kWh)",
-	SettingsSave:        "Save",
-	SettingsCancel:      "Cancel",
+	SettingsTitle:                 "Settings",
+	SettingsTheme:                 "Theme",
+	SettingsLanguage:              "Language",
+	SettingsDefaultFuel:           "Default Fuel Price (€/L)",
+	SettingsDefaultElec:           "Default Electricity Price (€/kWh)",
+	SettingsSave:                  "Save",
+	SettingsCancel:                "Cancel",
+	SettingsFuelCO2:               "CO2 %s (kg/L)",
+	SettingsGridCO2:               "CO2 %s (g/kWh)",
+	SettingsFuelEscalation:        "Fuel Price Escalation (%/year)",
+	SettingsElecEscalation:        "Electricity Price Escalation (%/year)",
+	SettingsInsuranceEscalation:   "Insurance Escalation (%/year)",
+	SettingsTaxEscalation:         "Vehicle Tax Escalation (%/year)",
+	SettingsMaintenanceEscalation: "Maintenance Escalation (%/year)",
+	SettingsMaintenanceAging:      "Maintenance Increase with Age (%/year)",
+	SettingsCO2Prices:             "CO2 Price Path (year: €/t; ...)",
+	SettingsDiscountRate:          "Discount Rate (%/year)",
+	SettingsIncludeCapitalCost:    "Include cost of capital in total cost",
 
 	ThemeLight: "Light",
 	ThemeDark:  "Dark",
@@ -442,6 +833,12 @@ func (a *App) translateElectricityType(electricityType string) string {
 		return translations.ElectricityTypeHome
 	case "public_charging_station":
 		return translations.ElectricityTypePublic
+	case "home_wallbox":
+		return translations.ElectricityTypeWallbox
+	case "workplace":
+		return translations.ElectricityTypeWorkplace
+	case "public_dc_fast":
+		return translations.ElectricityTypeFast
 	default:
 		return electricityType
 	}
@@ -462,7 +859,186 @@ func (a *App) getTranslatedElectricityTypes() []string {
 	translations := a.getCurrentTranslations()
 	return []string{
 		translations.ElectricityTypeHome,
+		translations.ElectricityTypeWallbox,
+		translations.ElectricityTypeWorkplace,
 		translations.ElectricityTypePublic,
+		translations.ElectricityTypeFast,
+	}
+}
+
+func (a *App) translateAcquisitionType(acquisitionType string) string {
+	translations := a.getCurrentTranslations()
+	switch acquisitionType {
+	case "cash":
+		return translations.AcquisitionTypeCash
+	case "loan":
+		return translations.AcquisitionTypeLoan
+	case "lease":
+		return translations.AcquisitionTypeLease
+	default:
+		return acquisitionType
+	}
+}
+
+func (a *App) getTranslatedAcquisitionTypes() []string {
+	translations := a.getCurrentTranslations()
+	return []string{
+		translations.AcquisitionTypeCash,
+		translations.AcquisitionTypeLoan,
+		translations.AcquisitionTypeLease,
+	}
+}
+
+func (a *App) translateDepreciationModel(depreciationModel string) string {
+	translations := a.getCurrentTranslations()
+	switch depreciationModel {
+	case "", "linear":
+		return translations.DepreciationLinear
+	case "declining_balance":
+		return translations.DepreciationDecliningBalance
+	case "age_mileage":
+		return translations.DepreciationAgeMileage
+	case "resale_value":
+		return translations.DepreciationResaleValue
+	default:
+		return depreciationModel
+	}
+}
+
+func (a *App) getTranslatedDepreciationModels() []string {
+	translations := a.getCurrentTranslations()
+	return []string{
+		translations.DepreciationLinear,
+		translations.DepreciationDecliningBalance,
+		translations.DepreciationAgeMileage,
+		translations.DepreciationResaleValue,
+	}
+}
+
+func (a *App) translateCompanyCarMethod(method string) string {
+	translations := a.getCurrentTranslations()
+	switch method {
+	case "", "flat_rate":
+		return translations.CompanyCarFlatRate
+	case "one_percent":
+		return translations.CompanyCarOnePercent
+	case "half_percent":
+		return translations.CompanyCarHalfPercent
+	case "quarter_percent":
+		return translations.CompanyCarQuarterPercent
+	case "logbook":
+		return translations.CompanyCarLogbook
+	default:
+		return method
+	}
+}
+
+func (a *App) getTranslatedCompanyCarMethods() []string {
+	translations := a.getCurrentTranslations()
+	return []string{
+		translations.CompanyCarFlatRate,
+		translations.CompanyCarOnePercent,
+		translations.CompanyCarHalfPercent,
+		translations.CompanyCarQuarterPercent,
+		translations.CompanyCarLogbook,
+	}
+}
+
+func (a *App) translateInsuranceCoverage(coverage string) string {
+	translations := a.getCurrentTranslations()
+	switch coverage {
+	case "", "liability":
+		return translations.CoverageLiability
+	case "partial":
+		return translations.CoveragePartial
+	case "comprehensive":
+		return translations.CoverageComprehensive
+	default:
+		return coverage
+	}
+}
+
+func (a *App) getTranslatedInsuranceCoverages() []string {
+	translations := a.getCurrentTranslations()
+	return []string{
+		translations.CoverageLiability,
+		translations.CoveragePartial,
+		translations.CoverageComprehensive,
+	}
+}
+
+func (a *App) translateCostRecurrence(recurrence string) string {
+	translations := a.getCurrentTranslations()
+	switch recurrence {
+	case "once":
+		return translations.RecurrenceOnce
+	case "monthly":
+		return translations.RecurrenceMonthly
+	case "annual":
+		return translations.RecurrenceAnnual
+	case "per_km":
+		return translations.RecurrencePerKm
+	default:
+		return recurrence
+	}
+}
+
+func (a *App) getTranslatedCostRecurrences() []string {
+	translations := a.getCurrentTranslations()
+	return []string{
+		translations.RecurrenceOnce,
+		translations.RecurrenceMonthly,
+		translations.RecurrenceAnnual,
+		translations.RecurrencePerKm,
+	}
+}
+
+func (a *App) translateDistributionType(distribution string) string {
+	translations := a.getCurrentTranslations()
+	switch distribution {
+	case "", "normal":
+		return translations.DistributionNormal
+	case "uniform":
+		return translations.DistributionUniform
+	case "triangular":
+		return translations.DistributionTriangular
+	default:
+		return distribution
+	}
+}
+
+func (a *App) getTranslatedDistributionTypes() []string {
+	translations := a.getCurrentTranslations()
+	return []string{
+		translations.DistributionNormal,
+		translations.DistributionUniform,
+		translations.DistributionTriangular,
+	}
+}
+
+func (a *App) translateTripFrequency(frequency string) string {
+	translations := a.getCurrentTranslations()
+	switch frequency {
+	case "", "workday":
+		return translations.TripWorkday
+	case "weekly":
+		return translations.TripWeekly
+	case "monthly":
+		return translations.TripMonthly
+	case "annual":
+		return translations.TripAnnual
+	default:
+		return frequency
+	}
+}
+
+func (a *App) getTranslatedTripFrequencies() []string {
+	translations := a.getCurrentTranslations()
+	return []string{
+		translations.TripWorkday,
+		translations.TripWeekly,
+		translations.TripMonthly,
+		translations.TripAnnual,
 	}
 }
 
@@ -491,6 +1067,120 @@ func (a *App) getElectricityTypeFromTranslation(translation string) string {
 		return "home_socket"
 	case translations.ElectricityTypePublic:
 		return "public_charging_station"
+	case translations.ElectricityTypeWallbox:
+		return "home_wallbox"
+	case translations.ElectricityTypeWorkplace:
+		return "workplace"
+	case translations.ElectricityTypeFast:
+		return "public_dc_fast"
+	default:
+		return translation
+	}
+}
+
+func (a *App) getAcquisitionTypeFromTranslation(translation string) string {
+	translations := a.getCurrentTranslations()
+	switch translation {
+	case translations.AcquisitionTypeCash:
+		return "cash"
+	case translations.AcquisitionTypeLoan:
+		return "loan"
+	case translations.AcquisitionTypeLease:
+		return "lease"
+	default:
+		return translation
+	}
+}
+
+func (a *App) getDepreciationModelFromTranslation(translation string) string {
+	translations := a.getCurrentTranslations()
+	switch translation {
+	case translations.DepreciationLinear:
+		return "linear"
+	case translations.DepreciationDecliningBalance:
+		return "declining_balance"
+	case translations.DepreciationAgeMileage:
+		return "age_mileage"
+	case translations.DepreciationResaleValue:
+		return "resale_value"
+	default:
+		return translation
+	}
+}
+
+func (a *App) getCompanyCarMethodFromTranslation(translation string) string {
+	translations := a.getCurrentTranslations()
+	switch translation {
+	case translations.CompanyCarFlatRate:
+		return "flat_rate"
+	case translations.CompanyCarOnePercent:
+		return "one_percent"
+	case translations.CompanyCarHalfPercent:
+		return "half_percent"
+	case translations.CompanyCarQuarterPercent:
+		return "quarter_percent"
+	case translations.CompanyCarLogbook:
+		return "logbook"
+	default:
+		return translation
+	}
+}
+
+func (a *App) getInsuranceCoverageFromTranslation(translation string) string {
+	translations := a.getCurrentTranslations()
+	switch translation {
+	case translations.CoverageLiability:
+		return "liability"
+	case translations.CoveragePartial:
+		return "partial"
+	case translations.CoverageComprehensive:
+		return "comprehensive"
+	default:
+		return translation
+	}
+}
+
+func (a *App) getCostRecurrenceFromTranslation(translation string) string {
+	translations := a.getCurrentTranslations()
+	switch translation {
+	case translations.RecurrenceOnce:
+		return "once"
+	case translations.RecurrenceMonthly:
+		return "monthly"
+	case translations.RecurrenceAnnual:
+		return "annual"
+	case translations.RecurrencePerKm:
+		return "per_km"
+	default:
+		return translation
+	}
+}
+
+func (a *App) getDistributionTypeFromTranslation(translation string) string {
+	translations := a.getCurrentTranslations()
+	switch translation {
+	case translations.DistributionNormal:
+		return "normal"
+	case translations.DistributionUniform:
+		return "uniform"
+	case translations.DistributionTriangular:
+		return "triangular"
+	default:
+		return translation
+	}
+}
+
+func (a *App) getTripFrequencyFromTranslation(translation string) string {
+	translations := a.getCurrentTranslations()
+	switch translation {
+	case translations.TripWorkday:
+		return "workday"
+	case translations.TripWeekly:
+		return "weekly"
+	case translations.TripMonthly:
+		return "monthly"
+	case translations.TripAnnual:
+		return "annual"
 	default:
 		return translation
 	}
@@ -77,6 +77,13 @@ func (a *App) createInputForm() *fyne.Container {
 		}
 	})
 
+	// Charging efficiency
+	a.chargingEfficiencyEntry = widget.NewEntry()
+	a.chargingEfficiencyEntry.SetPlaceHolder("z.B. 88")
+	a.chargingEfficiencyEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "charging_efficiency")
+	}
+
 	// Tank size
 	a.tankSizeEntry = widget.NewEntry()
 	a.tankSizeEntry.SetPlaceHolder("z.B. 50")
@@ -91,6 +98,34 @@ func (a *App) createInputForm() *fyne.Container {
 		a.updateProfileFromEntry(text, "battery_size")
 	}
 
+	// Battery aging per year
+	a.batteryAgingPerYearEntry = widget.NewEntry()
+	a.batteryAgingPerYearEntry.SetPlaceHolder("z.B. 1")
+	a.batteryAgingPerYearEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "battery_aging_per_year")
+	}
+
+	// Battery aging per 100.000 km
+	a.batteryAgingPer100kEntry = widget.NewEntry()
+	a.batteryAgingPer100kEntry.SetPlaceHolder("z.B. 4")
+	a.batteryAgingPer100kEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "battery_aging_per_100k")
+	}
+
+	// Battery replacement cost
+	a.batteryReplacementCostEntry = widget.NewEntry()
+	a.batteryReplacementCostEntry.SetPlaceHolder("z.B. 15000")
+	a.batteryReplacementCostEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "battery_replacement_cost")
+	}
+
+	// Battery replacement threshold
+	a.batteryReplacementHealthEntry = widget.NewEntry()
+	a.batteryReplacementHealthEntry.SetPlaceHolder("z.B. 70")
+	a.batteryReplacementHealthEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "battery_replacement_health")
+	}
+
 	// Monthly kilometers
 	a.monthlyKmEntry = widget.NewEntry()
 	a.monthlyKmEntry.SetPlaceHolder("z.B. 1500")
@@ -98,6 +133,35 @@ func (a *App) createInputForm() *fyne.Container {
 		a.updateProfileFromEntry(text, "monthly_km")
 	}
 
+	// Electric driving share
+	a.electricShareEntry = widget.NewEntry()
+	a.electricShareEntry.SetPlaceHolder("z.B. 60")
+	a.electricShareEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "electric_share")
+	}
+
+	// Daily commute
+	a.dailyCommuteEntry = widget.NewEntry()
+	a.dailyCommuteEntry.SetPlaceHolder("z.B. 40")
+	a.dailyCommuteEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "daily_commute_km")
+	}
+
+	// Seasonal consumption factors
+	a.seasonalFactorsEntry = widget.NewEntry()
+	a.seasonalFactorsEntry.SetPlaceHolder("Standard nach Antrieb")
+	a.seasonalFactorsEntry.OnChanged = func(text string) {
+		if a.currentProfile == nil {
+			return
+		}
+		factors, err := parseSeasonalFactors(text)
+		if err != nil {
+			return // Incomplete or invalid factors, skip update
+		}
+		a.currentProfile.SeasonalFactors = factors
+		a.updateResults()
+	}
+
 	// Annual tax
 	a.annualTaxEntry = widget.NewEntry()
 	a.annualTaxEntry.SetPlaceHolder("z.B. 200")
@@ -105,6 +169,55 @@ func (a *App) createInputForm() *fyne.Container {
 		a.updateProfileFromEntry(text, "annual_tax")
 	}
 
+	// Automatic car tax
+	a.autoCarTaxCheck = widget.NewCheck(translations.AutoCarTax, func(checked bool) {
+		if checked {
+			a.annualTaxEntry.Disable()
+		} else {
+			a.annualTaxEntry.Enable()
+		}
+		if a.currentProfile != nil && a.currentProfile.AutoCarTax != checked {
+			a.currentProfile.AutoCarTax = checked
+			a.updateResults()
+		}
+	})
+
+	// First registration
+	a.firstRegistrationEntry = widget.NewEntry()
+	a.firstRegistrationEntry.SetPlaceHolder("z.B. 15.03.2021")
+	a.firstRegistrationEntry.OnChanged = func(text string) {
+		if a.currentProfile == nil {
+			return
+		}
+		date, err := ParseGermanDate(text)
+		if err != nil && text != "" {
+			return // Invalid date, skip update
+		}
+		a.currentProfile.FirstRegistration = date
+		a.updateResults()
+	}
+
+	// Engine displacement
+	a.engineDisplacementEntry = widget.NewEntry()
+	a.engineDisplacementEntry.SetPlaceHolder("z.B. 1498")
+	a.engineDisplacementEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "engine_displacement")
+	}
+
+	// CO2 emissions
+	a.co2EmissionsEntry = widget.NewEntry()
+	a.co2EmissionsEntry.SetPlaceHolder("z.B. 130")
+	a.co2EmissionsEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "co2_emissions")
+	}
+
+	// Permissible total weight
+	a.vehicleWeightEntry = widget.NewEntry()
+	a.vehicleWeightEntry.SetPlaceHolder("z.B. 2300")
+	a.vehicleWeightEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "vehicle_weight")
+	}
+
 	// Annual insurance
 	a.annualInsuranceEntry = widget.NewEntry()
 	a.annualInsuranceEntry.SetPlaceHolder("z.B. 800")
@@ -112,6 +225,17 @@ func (a *App) createInputForm() *fyne.Container {
 		a.updateProfileFromEntry(text, "annual_insurance")
 	}
 
+	// Acquisition type
+	acquisitionTypes := a.getTranslatedAcquisitionTypes()
+	a.acquisitionTypeSelect = widget.NewSelect(acquisitionTypes, func(value string) {
+		acquisitionType := models.AcquisitionType(a.getAcquisitionTypeFromTranslation(value))
+		a.updateFinancingForms(acquisitionType)
+		if a.currentProfile != nil {
+			a.currentProfile.AcquisitionType = acquisitionType
+			a.updateResults()
+		}
+	})
+
 	// Financing rate
 	a.financingRateEntry = widget.NewEntry()
 	a.financingRateEntry.SetPlaceHolder("z.B. 350")
@@ -126,6 +250,338 @@ func (a *App) createInputForm() *fyne.Container {
 		a.updateProfileFromEntry(text, "financing_period")
 	}
 
+	// Down payment
+	a.downPaymentEntry = widget.NewEntry()
+	a.downPaymentEntry.SetPlaceHolder("z.B. 5000")
+	a.downPaymentEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "down_payment")
+	}
+
+	// Interest rate
+	a.interestRateEntry = widget.NewEntry()
+	a.interestRateEntry.SetPlaceHolder("z.B. 5,9")
+	a.interestRateEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "interest_rate")
+	}
+
+	// Balloon payment
+	a.balloonPaymentEntry = widget.NewEntry()
+	a.balloonPaymentEntry.SetPlaceHolder("z.B. 10000")
+	a.balloonPaymentEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "balloon_payment")
+	}
+
+	// Lease special payment
+	a.leaseSpecialPaymentEntry = widget.NewEntry()
+	a.leaseSpecialPaymentEntry.SetPlaceHolder("z.B. 3000")
+	a.leaseSpecialPaymentEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "lease_special_payment")
+	}
+
+	// Lease annual kilometers
+	a.leaseAnnualKmEntry = widget.NewEntry()
+	a.leaseAnnualKmEntry.SetPlaceHolder("z.B. 15000")
+	a.leaseAnnualKmEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "lease_annual_km")
+	}
+
+	// Lease excess kilometer cost
+	a.leaseExcessKmCostEntry = widget.NewEntry()
+	a.leaseExcessKmCostEntry.SetPlaceHolder("z.B. 0,08")
+	a.leaseExcessKmCostEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "lease_excess_km_cost")
+	}
+
+	// Lease unused kilometer credit
+	a.leaseUnusedKmCreditEntry = widget.NewEntry()
+	a.leaseUnusedKmCreditEntry.SetPlaceHolder("z.B. 0,05")
+	a.leaseUnusedKmCreditEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "lease_unused_km_credit")
+	}
+
+	// Service cost
+	a.serviceCostEntry = widget.NewEntry()
+	a.serviceCostEntry.SetPlaceHolder("z.B. 350")
+	a.serviceCostEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "service_cost")
+	}
+
+	// Service interval in months
+	a.serviceIntervalMonthsEntry = widget.NewEntry()
+	a.serviceIntervalMonthsEntry.SetPlaceHolder("z.B. 12")
+	a.serviceIntervalMonthsEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "service_interval_months")
+	}
+
+	// Service interval in kilometers
+	a.serviceIntervalKmEntry = widget.NewEntry()
+	a.serviceIntervalKmEntry.SetPlaceHolder("z.B. 30000")
+	a.serviceIntervalKmEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "service_interval_km")
+	}
+
+	// HU/AU cost
+	a.inspectionCostEntry = widget.NewEntry()
+	a.inspectionCostEntry.SetPlaceHolder("z.B. 150")
+	a.inspectionCostEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "inspection_cost")
+	}
+
+	// HU/AU interval in months
+	a.inspectionIntervalEntry = widget.NewEntry()
+	a.inspectionIntervalEntry.SetPlaceHolder("z.B. 24")
+	a.inspectionIntervalEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "inspection_interval_months")
+	}
+
+	// Tire cost
+	a.tireCostEntry = widget.NewEntry()
+	a.tireCostEntry.SetPlaceHolder("z.B. 600")
+	a.tireCostEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "tire_cost")
+	}
+
+	// Tire lifetime
+	a.tireLifetimeKmEntry = widget.NewEntry()
+	a.tireLifetimeKmEntry.SetPlaceHolder("z.B. 40000")
+	a.tireLifetimeKmEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "tire_lifetime_km")
+	}
+
+	// Brake cost
+	a.brakeCostEntry = widget.NewEntry()
+	a.brakeCostEntry.SetPlaceHolder("z.B. 400")
+	a.brakeCostEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "brake_cost")
+	}
+
+	// Brake interval
+	a.brakeIntervalKmEntry = widget.NewEntry()
+	a.brakeIntervalKmEntry.SetPlaceHolder("z.B. 60000")
+	a.brakeIntervalKmEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "brake_interval_km")
+	}
+
+	// Repair reserve
+	a.repairReserveEntry = widget.NewEntry()
+	a.repairReserveEntry.SetPlaceHolder("z.B. 300")
+	a.repairReserveEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "annual_repair_reserve")
+	}
+
+	// Annual THG-Quote revenue
+	a.annualTHGRevenueEntry = widget.NewEntry()
+	a.annualTHGRevenueEntry.SetPlaceHolder("z.B. 250")
+	a.annualTHGRevenueEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "annual_thg_revenue")
+	}
+
+	// Purchase subsidies and dealer bonuses
+	a.purchaseIncentivesEntry = widget.NewEntry()
+	a.purchaseIncentivesEntry.SetPlaceHolder("z.B. 3000")
+	a.purchaseIncentivesEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "purchase_incentives")
+	}
+
+	// Depreciation model
+	depreciationModels := a.getTranslatedDepreciationModels()
+	a.depreciationModelSelect = widget.NewSelect(depreciationModels, func(value string) {
+		if a.currentProfile != nil {
+			a.currentProfile.DepreciationModel = models.DepreciationModelType(a.getDepreciationModelFromTranslation(value))
+			a.updateResults()
+		}
+	})
+
+	// Depreciation rate
+	a.depreciationRateEntry = widget.NewEntry()
+	a.depreciationRateEntry.SetPlaceHolder("z.B. 15")
+	a.depreciationRateEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "depreciation_rate")
+	}
+
+	// Expected resale value
+	a.expectedResaleValueEntry = widget.NewEntry()
+	a.expectedResaleValueEntry.SetPlaceHolder("z.B. 12000")
+	a.expectedResaleValueEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "expected_resale_value")
+	}
+
+	// Company car
+	a.companyCarCheck = widget.NewCheck(translations.CompanyCar, func(checked bool) {
+		if checked {
+			a.companyCarForm.Show()
+		} else {
+			a.companyCarForm.Hide()
+		}
+		if a.currentProfile != nil && a.currentProfile.CompanyCar != checked {
+			a.currentProfile.CompanyCar = checked
+			a.updateResults()
+		}
+	})
+
+	// Company car taxation method
+	companyCarMethods := a.getTranslatedCompanyCarMethods()
+	a.companyCarMethodSelect = widget.NewSelect(companyCarMethods, func(value string) {
+		if a.currentProfile != nil {
+			a.currentProfile.CompanyCarMethod = models.CompanyCarMethod(a.getCompanyCarMethodFromTranslation(value))
+			a.updateResults()
+		}
+	})
+
+	// Commute distance, one-way
+	a.commuteDistanceEntry = widget.NewEntry()
+	a.commuteDistanceEntry.SetPlaceHolder("z.B. 25")
+	a.commuteDistanceEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "commute_distance")
+	}
+
+	// Private share per logbook
+	a.privateUseShareEntry = widget.NewEntry()
+	a.privateUseShareEntry.SetPlaceHolder("z.B. 30")
+	a.privateUseShareEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "private_use_share")
+	}
+
+	// Personal marginal tax rate
+	a.personalTaxRateEntry = widget.NewEntry()
+	a.personalTaxRateEntry.SetPlaceHolder("z.B. 42")
+	a.personalTaxRateEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "personal_tax_rate")
+	}
+
+	// Fuel consumption in the city
+	a.fuelCityEntry = widget.NewEntry()
+	a.fuelCityEntry.SetPlaceHolder("z.B. 7,5")
+	a.fuelCityEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "fuel_consumption_city")
+	}
+
+	// Fuel consumption on rural roads
+	a.fuelRuralEntry = widget.NewEntry()
+	a.fuelRuralEntry.SetPlaceHolder("z.B. 5,2")
+	a.fuelRuralEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "fuel_consumption_rural")
+	}
+
+	// Fuel consumption on the motorway
+	a.fuelMotorwayEntry = widget.NewEntry()
+	a.fuelMotorwayEntry.SetPlaceHolder("z.B. 6,8")
+	a.fuelMotorwayEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "fuel_consumption_motorway")
+	}
+
+	// Electric consumption in the city
+	a.electricCityEntry = widget.NewEntry()
+	a.electricCityEntry.SetPlaceHolder("z.B. 14")
+	a.electricCityEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "electric_consumption_city")
+	}
+
+	// Electric consumption on rural roads
+	a.electricRuralEntry = widget.NewEntry()
+	a.electricRuralEntry.SetPlaceHolder("z.B. 15")
+	a.electricRuralEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "electric_consumption_rural")
+	}
+
+	// Electric consumption on the motorway
+	a.electricMotorwayEntry = widget.NewEntry()
+	a.electricMotorwayEntry.SetPlaceHolder("z.B. 22")
+	a.electricMotorwayEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "electric_consumption_motorway")
+	}
+
+	// Share of city driving
+	a.cityShareEntry = widget.NewEntry()
+	a.cityShareEntry.SetPlaceHolder("z.B. 30")
+	a.cityShareEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "city_share")
+	}
+
+	// Share of rural roads
+	a.ruralShareEntry = widget.NewEntry()
+	a.ruralShareEntry.SetPlaceHolder("z.B. 40")
+	a.ruralShareEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "rural_share")
+	}
+
+	// Share of motorway driving
+	a.motorwayShareEntry = widget.NewEntry()
+	a.motorwayShareEntry.SetPlaceHolder("z.B. 30")
+	a.motorwayShareEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "motorway_share")
+	}
+
+	// Driving profile by road type
+	a.drivingProfileCheck = widget.NewCheck(translations.DrivingProfile, func(checked bool) {
+		if checked {
+			a.drivingProfileContent.Show()
+		} else {
+			a.drivingProfileContent.Hide()
+		}
+		if a.currentProfile != nil && a.currentProfile.DrivingProfile != checked {
+			a.currentProfile.DrivingProfile = checked
+			a.updateResults()
+		}
+	})
+
+	// Insurance by SF class and coverage
+	a.insuranceModelCheck = widget.NewCheck(translations.InsuranceModel, func(checked bool) {
+		if checked {
+			a.insuranceForm.Show()
+		} else {
+			a.insuranceForm.Hide()
+		}
+		if a.currentProfile != nil && a.currentProfile.InsuranceModel != checked {
+			a.currentProfile.InsuranceModel = checked
+			a.updateResults()
+		}
+	})
+
+	// Insurance coverage
+	insuranceCoverages := a.getTranslatedInsuranceCoverages()
+	a.insuranceCoverageSelect = widget.NewSelect(insuranceCoverages, func(value string) {
+		if a.currentProfile != nil {
+			a.currentProfile.InsuranceCoverage = models.InsuranceCoverage(a.getInsuranceCoverageFromTranslation(value))
+			a.updateResults()
+		}
+	})
+
+	// SF class at purchase
+	a.insuranceSFClassEntry = widget.NewEntry()
+	a.insuranceSFClassEntry.SetPlaceHolder("z.B. 12")
+	a.insuranceSFClassEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "insurance_sf_class")
+	}
+
+	// Haftpflicht premium
+	a.insuranceLiabilityEntry = widget.NewEntry()
+	a.insuranceLiabilityEntry.SetPlaceHolder("z.B. 350")
+	a.insuranceLiabilityEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "insurance_liability_premium")
+	}
+
+	// Teilkasko premium
+	a.insurancePartialEntry = widget.NewEntry()
+	a.insurancePartialEntry.SetPlaceHolder("z.B. 120")
+	a.insurancePartialEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "insurance_partial_premium")
+	}
+
+	// Vollkasko premium
+	a.insuranceFullEntry = widget.NewEntry()
+	a.insuranceFullEntry.SetPlaceHolder("z.B. 450")
+	a.insuranceFullEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "insurance_full_premium")
+	}
+
+	// Switch from Vollkasko to Teilkasko
+	a.insuranceSwitchEntry = widget.NewEntry()
+	a.insuranceSwitchEntry.SetPlaceHolder("z.B. 5")
+	a.insuranceSwitchEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "insurance_switch_years")
+	}
+
 	// Purchase price
 	a.purchasePriceEntry = widget.NewEntry()
 	a.purchasePriceEntry.SetPlaceHolder("z.B. 35000")
@@ -133,6 +589,20 @@ func (a *App) createInputForm() *fyne.Container {
 		a.updateProfileFromEntry(text, "purchase_price")
 	}
 
+	// Odometer at purchase
+	a.odometerAtPurchaseEntry = widget.NewEntry()
+	a.odometerAtPurchaseEntry.SetPlaceHolder("z.B. 45000")
+	a.odometerAtPurchaseEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "odometer_at_purchase")
+	}
+
+	// Expected odometer at sale
+	a.odometerAtSaleEntry = widget.NewEntry()
+	a.odometerAtSaleEntry.SetPlaceHolder("z.B. 120000")
+	a.odometerAtSaleEntry.OnChanged = func(text string) {
+		a.updateProfileFromEntry(text, "odometer_at_sale")
+	}
+
 	// Ownership years
 	a.ownershipYearsEntry = widget.NewEntry()
 	a.ownershipYearsEntry.SetPlaceHolder("z.B. 5")
@@ -162,14 +632,21 @@ func (a *App) createInputForm() *fyne.Container {
 		widget.NewFormItem(translations.ElectricityPrice, a.electricityPriceEntry),
 		widget.NewFormItem(translations.FuelType, a.fuelTypeSelect),
 		widget.NewFormItem(translations.ElectricityType, a.electricityTypeSelect),
+		widget.NewFormItem(translations.ChargingEfficiency, a.chargingEfficiencyEntry),
 	)
 	pricesSection := container.NewVBox(
 		widget.NewCard(translations.PricesTitle, "", pricesForm),
 	)
 
+	chargingMixSection := a.createChargingMixSection()
+
 	capacityForm := widget.NewForm(
 		widget.NewFormItem(translations.TankSize, a.tankSizeEntry),
 		widget.NewFormItem(translations.BatterySize, a.batterySizeEntry),
+		widget.NewFormItem(translations.BatteryAgingPerYear, a.batteryAgingPerYearEntry),
+		widget.NewFormItem(translations.BatteryAgingPer100k, a.batteryAgingPer100kEntry),
+		widget.NewFormItem(translations.BatteryReplacementCost, a.batteryReplacementCostEntry),
+		widget.NewFormItem(translations.BatteryReplacementHealth, a.batteryReplacementHealthEntry),
 	)
 	capacitySection := container.NewVBox(
 		widget.NewCard(translations.CapacityTitle, "", capacityForm),
@@ -177,44 +654,139 @@ func (a *App) createInputForm() *fyne.Container {
 
 	usageForm := widget.NewForm(
 		widget.NewFormItem(translations.MonthlyKilometers, a.monthlyKmEntry),
+		widget.NewFormItem(translations.ElectricShare, a.electricShareEntry),
+		widget.NewFormItem(translations.DailyCommuteKm, a.dailyCommuteEntry),
+		widget.NewFormItem(translations.SeasonalFactors, a.seasonalFactorsEntry),
 	)
 	usageSection := container.NewVBox(
 		widget.NewCard(translations.UsageTitle, "", usageForm),
 	)
 
+	drivingProfileSection := a.createDrivingProfileSection()
+
 	costsForm := widget.NewForm(
 		widget.NewFormItem(translations.AnnualTax, a.annualTaxEntry),
+		widget.NewFormItem("", a.autoCarTaxCheck),
+		widget.NewFormItem(translations.FirstRegistration, a.firstRegistrationEntry),
+		widget.NewFormItem(translations.EngineDisplacement, a.engineDisplacementEntry),
+		widget.NewFormItem(translations.CO2Emissions, a.co2EmissionsEntry),
+		widget.NewFormItem(translations.VehicleWeight, a.vehicleWeightEntry),
 		widget.NewFormItem(translations.AnnualInsurance, a.annualInsuranceEntry),
 	)
 	costsSection := container.NewVBox(
 		widget.NewCard(translations.CostsTitle, "", costsForm),
 	)
 
+	a.insuranceForm = widget.NewForm(
+		widget.NewFormItem(translations.InsuranceCoverage, a.insuranceCoverageSelect),
+		widget.NewFormItem(translations.InsuranceSFClass, a.insuranceSFClassEntry),
+		widget.NewFormItem(translations.InsuranceLiabilityPremium, a.insuranceLiabilityEntry),
+		widget.NewFormItem(translations.InsurancePartialPremium, a.insurancePartialEntry),
+		widget.NewFormItem(translations.InsuranceFullPremium, a.insuranceFullEntry),
+		widget.NewFormItem(translations.InsuranceSwitchYears, a.insuranceSwitchEntry),
+	)
+	a.insuranceForm.Hide()
+	insuranceSection := container.NewVBox(
+		widget.NewCard(translations.InsuranceTitle, "", container.NewVBox(
+			a.insuranceModelCheck,
+			a.insuranceForm,
+		)),
+	)
+
 	financingForm := widget.NewForm(
+		widget.NewFormItem(translations.AcquisitionType, a.acquisitionTypeSelect),
 		widget.NewFormItem(translations.FinancingRate, a.financingRateEntry),
 		widget.NewFormItem(translations.FinancingPeriod, a.financingPeriodEntry),
 	)
+	a.loanForm = widget.NewForm(
+		widget.NewFormItem(translations.DownPayment, a.downPaymentEntry),
+		widget.NewFormItem(translations.InterestRate, a.interestRateEntry),
+		widget.NewFormItem(translations.BalloonPayment, a.balloonPaymentEntry),
+	)
+	a.leaseForm = widget.NewForm(
+		widget.NewFormItem(translations.LeaseSpecialPayment, a.leaseSpecialPaymentEntry),
+		widget.NewFormItem(translations.LeaseAnnualKilometers, a.leaseAnnualKmEntry),
+		widget.NewFormItem(translations.LeaseExcessKmCost, a.leaseExcessKmCostEntry),
+		widget.NewFormItem(translations.LeaseUnusedKmCredit, a.leaseUnusedKmCreditEntry),
+	)
+	a.updateFinancingForms(models.AcquisitionLoan)
 	financingSection := container.NewVBox(
-		widget.NewCard(translations.FinancingTitle, "", financingForm),
+		widget.NewCard(translations.FinancingTitle, "", container.NewVBox(
+			financingForm,
+			a.loanForm,
+			a.leaseForm,
+		)),
+	)
+
+	maintenanceForm := widget.NewForm(
+		widget.NewFormItem(translations.ServiceCost, a.serviceCostEntry),
+		widget.NewFormItem(translations.ServiceIntervalMonths, a.serviceIntervalMonthsEntry),
+		widget.NewFormItem(translations.ServiceIntervalKm, a.serviceIntervalKmEntry),
+		widget.NewFormItem(translations.InspectionCost, a.inspectionCostEntry),
+		widget.NewFormItem(translations.InspectionInterval, a.inspectionIntervalEntry),
+		widget.NewFormItem(translations.TireCost, a.tireCostEntry),
+		widget.NewFormItem(translations.TireLifetimeKm, a.tireLifetimeKmEntry),
+		widget.NewFormItem(translations.BrakeCost, a.brakeCostEntry),
+		widget.NewFormItem(translations.BrakeIntervalKm, a.brakeIntervalKmEntry),
+		widget.NewFormItem(translations.AnnualRepairReserve, a.repairReserveEntry),
+	)
+	maintenanceSection := container.NewVBox(
+		widget.NewCard(translations.MaintenanceTitle, "", maintenanceForm),
+	)
+
+	customCostsSection := a.createCustomCostsSection()
+
+	creditsForm := widget.NewForm(
+		widget.NewFormItem(translations.AnnualTHGRevenue, a.annualTHGRevenueEntry),
+		widget.NewFormItem(translations.PurchaseIncentives, a.purchaseIncentivesEntry),
+	)
+	creditsSection := container.NewVBox(
+		widget.NewCard(translations.CreditsTitle, "", creditsForm),
 	)
 
 	depreciationForm := widget.NewForm(
 		widget.NewFormItem(translations.PurchasePrice, a.purchasePriceEntry),
+		widget.NewFormItem(translations.OdometerAtPurchase, a.odometerAtPurchaseEntry),
+		widget.NewFormItem(translations.OdometerAtSale, a.odometerAtSaleEntry),
 		widget.NewFormItem(translations.OwnershipYears, a.ownershipYearsEntry),
+		widget.NewFormItem(translations.DepreciationModel, a.depreciationModelSelect),
+		widget.NewFormItem(translations.DepreciationRate, a.depreciationRateEntry),
+		widget.NewFormItem(translations.ExpectedResaleValue, a.expectedResaleValueEntry),
 	)
 	depreciationSection := container.NewVBox(
 		widget.NewCard(translations.DepreciationTitle, "", depreciationForm),
 	)
 
+	a.companyCarForm = widget.NewForm(
+		widget.NewFormItem(translations.CompanyCarMethod, a.companyCarMethodSelect),
+		widget.NewFormItem(translations.CommuteDistance, a.commuteDistanceEntry),
+		widget.NewFormItem(translations.PrivateUseShare, a.privateUseShareEntry),
+		widget.NewFormItem(translations.PersonalTaxRate, a.personalTaxRateEntry),
+	)
+	a.companyCarForm.Hide()
+	companyCarSection := container.NewVBox(
+		widget.NewCard(translations.CompanyCarTitle, "", container.NewVBox(
+			a.companyCarCheck,
+			a.companyCarForm,
+		)),
+	)
+
 	return container.NewVBox(
 		profileSection,
 		consumptionSection,
 		pricesSection,
+		chargingMixSection,
 		capacitySection,
 		usageSection,
+		drivingProfileSection,
 		costsSection,
+		insuranceSection,
+		maintenanceSection,
+		customCostsSection,
+		creditsSection,
 		financingSection,
 		depreciationSection,
+		companyCarSection,
 	)
 }
 
@@ -237,22 +809,124 @@ func (a *App) updateProfileFromEntry(text, field string) {
 		a.currentProfile.FuelPrice = value
 	case "electricity_price":
 		a.currentProfile.ElectricityPrice = value
+	case "charging_efficiency":
+		a.currentProfile.ChargingEfficiency = value
 	case "tank_size":
 		a.currentProfile.TankSize = value
 	case "battery_size":
 		a.currentProfile.BatterySize = value
+	case "battery_aging_per_year":
+		a.currentProfile.BatteryAgingPerYear = value
+	case "battery_aging_per_100k":
+		a.currentProfile.BatteryAgingPer100k = value
+	case "battery_replacement_cost":
+		a.currentProfile.BatteryReplacementCost = value
+	case "battery_replacement_health":
+		a.currentProfile.BatteryReplacementHealth = value
 	case "monthly_km":
 		a.currentProfile.MonthlyKilometers = value
 	case "annual_tax":
 		a.currentProfile.AnnualCarTax = value
+	case "engine_displacement":
+		a.currentProfile.EngineDisplacement = value
+	case "co2_emissions":
+		a.currentProfile.CO2Emissions = value
+	case "vehicle_weight":
+		a.currentProfile.VehicleWeight = value
 	case "annual_insurance":
 		a.currentProfile.AnnualCarInsurance = value
 	case "financing_rate":
 		a.currentProfile.FinancingRate = value
 	case "purchase_price":
 		a.currentProfile.PurchasePrice = value
+	case "odometer_at_purchase":
+		a.currentProfile.OdometerAtPurchase = value
+	case "odometer_at_sale":
+		a.currentProfile.OdometerAtSale = value
+	case "insurance_sf_class":
+		a.currentProfile.InsuranceSFClass = int(value)
+	case "insurance_liability_premium":
+		a.currentProfile.InsuranceLiabilityPremium = value
+	case "insurance_partial_premium":
+		a.currentProfile.InsurancePartialPremium = value
+	case "insurance_full_premium":
+		a.currentProfile.InsuranceFullPremium = value
+	case "insurance_switch_years":
+		a.currentProfile.InsuranceSwitchYears = int(value)
 	case "financing_period":
 		a.currentProfile.FinancingPeriod = int(value)
+	case "down_payment":
+		a.currentProfile.DownPayment = value
+	case "interest_rate":
+		a.currentProfile.InterestRate = value
+	case "balloon_payment":
+		a.currentProfile.BalloonPayment = value
+	case "lease_special_payment":
+		a.currentProfile.LeaseSpecialPayment = value
+	case "lease_annual_km":
+		a.currentProfile.LeaseAnnualKilometers = value
+	case "lease_excess_km_cost":
+		a.currentProfile.LeaseExcessKmCost = value
+	case "lease_unused_km_credit":
+		a.currentProfile.LeaseUnusedKmCredit = value
+	case "service_cost":
+		a.currentProfile.ServiceCost = value
+	case "service_interval_months":
+		a.currentProfile.ServiceIntervalMonths = int(value)
+	case "service_interval_km":
+		a.currentProfile.ServiceIntervalKm = value
+	case "inspection_cost":
+		a.currentProfile.InspectionCost = value
+	case "inspection_interval_months":
+		a.currentProfile.InspectionIntervalMonths = int(value)
+	case "tire_cost":
+		a.currentProfile.TireCost = value
+	case "tire_lifetime_km":
+		a.currentProfile.TireLifetimeKm = value
+	case "brake_cost":
+		a.currentProfile.BrakeCost = value
+	case "brake_interval_km":
+		a.currentProfile.BrakeIntervalKm = value
+	case "annual_repair_reserve":
+		a.currentProfile.AnnualRepairReserve = value
+	case "electric_share":
+		// An empty share is derived from the commute or the default
+		a.currentProfile.ElectricShare = value
+		a.currentProfile.ElectricShareSet = strings.TrimSpace(text) != ""
+	case "daily_commute_km":
+		a.currentProfile.DailyCommuteKm = value
+	case "annual_thg_revenue":
+		a.currentProfile.AnnualTHGRevenue = value
+	case "purchase_incentives":
+		a.currentProfile.PurchaseIncentives = value
+	case "depreciation_rate":
+		a.currentProfile.DepreciationRate = value
+	case "expected_resale_value":
+		a.currentProfile.ExpectedResaleValue = value
+	case "commute_distance":
+		a.currentProfile.CommuteDistance = value
+	case "private_use_share":
+		a.currentProfile.PrivateUseShare = value
+	case "personal_tax_rate":
+		a.currentProfile.PersonalTaxRate = value
+	case "fuel_consumption_city":
+		a.currentProfile.FuelConsumptionByRoad.City = value
+	case "fuel_consumption_rural":
+		a.currentProfile.FuelConsumptionByRoad.Rural = value
+	case "fuel_consumption_motorway":
+		a.currentProfile.FuelConsumptionByRoad.Motorway = value
+	case "electric_consumption_city":
+		a.currentProfile.ElectricConsumptionByRoad.City = value
+	case "electric_consumption_rural":
+		a.currentProfile.ElectricConsumptionByRoad.Rural = value
+	case "electric_consumption_motorway":
+		a.currentProfile.ElectricConsumptionByRoad.Motorway = value
+	case "city_share":
+		a.currentProfile.DrivingMix.City = value
+	case "rural_share":
+		a.currentProfile.DrivingMix.Rural = value
+	case "motorway_share":
+		a.currentProfile.DrivingMix.Motorway = value
 	case "ownership_years":
 		a.currentProfile.ExpectedYearsOfOwnership = int(value)
 	}
@@ -272,15 +946,81 @@ func (a *App) updateInputForm() {
 	a.electricityPriceEntry.SetText(FormatGermanNumber(a.currentProfile.ElectricityPrice, 2))
 	a.fuelTypeSelect.SetSelected(a.translateFuelType(string(a.currentProfile.FuelType)))
 	a.electricityTypeSelect.SetSelected(a.translateElectricityType(string(a.currentProfile.ElectricityType)))
+	a.chargingEfficiencyEntry.SetText(FormatGermanNumber(a.currentProfile.ChargingEfficiency, 0))
 	a.tankSizeEntry.SetText(FormatGermanNumber(a.currentProfile.TankSize, 0))
 	a.batterySizeEntry.SetText(FormatGermanNumber(a.currentProfile.BatterySize, 0))
+	a.batteryAgingPerYearEntry.SetText(FormatGermanNumber(a.currentProfile.BatteryAgingPerYear, 1))
+	a.batteryAgingPer100kEntry.SetText(FormatGermanNumber(a.currentProfile.BatteryAgingPer100k, 1))
+	a.batteryReplacementCostEntry.SetText(FormatGermanNumber(a.currentProfile.BatteryReplacementCost, 0))
+	a.batteryReplacementHealthEntry.SetText(FormatGermanNumber(a.currentProfile.BatteryReplacementHealth, 0))
 	a.monthlyKmEntry.SetText(FormatGermanNumber(a.currentProfile.MonthlyKilometers, 0))
 	a.annualTaxEntry.SetText(FormatGermanNumber(a.currentProfile.AnnualCarTax, 0))
+	a.autoCarTaxCheck.SetChecked(a.currentProfile.AutoCarTax)
+	a.firstRegistrationEntry.SetText(FormatGermanDate(a.currentProfile.FirstRegistration))
+	a.engineDisplacementEntry.SetText(FormatGermanNumber(a.currentProfile.EngineDisplacement, 0))
+	a.co2EmissionsEntry.SetText(FormatGermanNumber(a.currentProfile.CO2Emissions, 0))
+	a.vehicleWeightEntry.SetText(FormatGermanNumber(a.currentProfile.VehicleWeight, 0))
 	a.annualInsuranceEntry.SetText(FormatGermanNumber(a.currentProfile.AnnualCarInsurance, 0))
+	a.insuranceSFClassEntry.SetText(fmt.Sprintf("%d", a.currentProfile.InsuranceSFClass))
+	a.insuranceLiabilityEntry.SetText(FormatGermanNumber(a.currentProfile.InsuranceLiabilityPremium, 0))
+	a.insurancePartialEntry.SetText(FormatGermanNumber(a.currentProfile.InsurancePartialPremium, 0))
+	a.insuranceFullEntry.SetText(FormatGermanNumber(a.currentProfile.InsuranceFullPremium, 0))
+	a.insuranceSwitchEntry.SetText(fmt.Sprintf("%d", a.currentProfile.InsuranceSwitchYears))
+	a.acquisitionTypeSelect.SetSelected(a.translateAcquisitionType(string(a.displayedAcquisitionType())))
 	a.financingRateEntry.SetText(FormatGermanNumber(a.currentProfile.FinancingRate, 0))
 	a.financingPeriodEntry.SetText(fmt.Sprintf("%d", a.currentProfile.FinancingPeriod))
+	a.downPaymentEntry.SetText(FormatGermanNumber(a.currentProfile.DownPayment, 0))
+	a.interestRateEntry.SetText(FormatGermanNumber(a.currentProfile.InterestRate, 2))
+	a.balloonPaymentEntry.SetText(FormatGermanNumber(a.currentProfile.BalloonPayment, 0))
+	a.leaseSpecialPaymentEntry.SetText(FormatGermanNumber(a.currentProfile.LeaseSpecialPayment, 0))
+	a.leaseAnnualKmEntry.SetText(FormatGermanNumber(a.currentProfile.LeaseAnnualKilometers, 0))
+	a.leaseExcessKmCostEntry.SetText(FormatGermanNumber(a.currentProfile.LeaseExcessKmCost, 2))
+	a.leaseUnusedKmCreditEntry.SetText(FormatGermanNumber(a.currentProfile.LeaseUnusedKmCredit, 2))
+	a.depreciationModelSelect.SetSelected(a.translateDepreciationModel(string(a.currentProfile.DepreciationModel)))
+	a.electricShareEntry.SetText("")
+	if a.currentProfile.ElectricShareSet || a.currentProfile.ElectricShare > 0 {
+		a.electricShareEntry.SetText(FormatGermanNumber(a.currentProfile.ElectricShare, 0))
+	}
+	a.dailyCommuteEntry.SetText(FormatGermanNumber(a.currentProfile.DailyCommuteKm, 0))
+	a.seasonalFactorsEntry.SetText(formatSeasonalFactors(a.currentProfile.SeasonalFactors))
+	a.serviceCostEntry.SetText(FormatGermanNumber(a.currentProfile.ServiceCost, 0))
+	a.serviceIntervalMonthsEntry.SetText(fmt.Sprintf("%d", a.currentProfile.ServiceIntervalMonths))
+	a.serviceIntervalKmEntry.SetText(FormatGermanNumber(a.currentProfile.ServiceIntervalKm, 0))
+	a.inspectionCostEntry.SetText(FormatGermanNumber(a.currentProfile.InspectionCost, 0))
+	a.inspectionIntervalEntry.SetText(fmt.Sprintf("%d", a.currentProfile.InspectionIntervalMonths))
+	a.tireCostEntry.SetText(FormatGermanNumber(a.currentProfile.TireCost, 0))
+	a.tireLifetimeKmEntry.SetText(FormatGermanNumber(a.currentProfile.TireLifetimeKm, 0))
+	a.brakeCostEntry.SetText(FormatGermanNumber(a.currentProfile.BrakeCost, 0))
+	a.brakeIntervalKmEntry.SetText(FormatGermanNumber(a.currentProfile.BrakeIntervalKm, 0))
+	a.repairReserveEntry.SetText(FormatGermanNumber(a.currentProfile.AnnualRepairReserve, 0))
+	a.annualTHGRevenueEntry.SetText(FormatGermanNumber(a.currentProfile.AnnualTHGRevenue, 0))
+	a.purchaseIncentivesEntry.SetText(FormatGermanNumber(a.currentProfile.PurchaseIncentives, 0))
+	a.depreciationRateEntry.SetText(FormatGermanNumber(a.currentProfile.DepreciationRate, 1))
+	a.expectedResaleValueEntry.SetText(FormatGermanNumber(a.currentProfile.ExpectedResaleValue, 0))
+	a.companyCarCheck.SetChecked(a.currentProfile.CompanyCar)
+	a.companyCarMethodSelect.SetSelected(a.translateCompanyCarMethod(string(a.currentProfile.CompanyCarMethod)))
+	a.commuteDistanceEntry.SetText(FormatGermanNumber(a.currentProfile.CommuteDistance, 0))
+	a.privateUseShareEntry.SetText(FormatGermanNumber(a.currentProfile.PrivateUseShare, 0))
+	a.personalTaxRateEntry.SetText(FormatGermanNumber(a.currentProfile.PersonalTaxRate, 0))
+	a.fuelCityEntry.SetText(FormatGermanNumber(a.currentProfile.FuelConsumptionByRoad.City, 1))
+	a.fuelRuralEntry.SetText(FormatGermanNumber(a.currentProfile.FuelConsumptionByRoad.Rural, 1))
+	a.fuelMotorwayEntry.SetText(FormatGermanNumber(a.currentProfile.FuelConsumptionByRoad.Motorway, 1))
+	a.electricCityEntry.SetText(FormatGermanNumber(a.currentProfile.ElectricConsumptionByRoad.City, 1))
+	a.electricRuralEntry.SetText(FormatGermanNumber(a.currentProfile.ElectricConsumptionByRoad.Rural, 1))
+	a.electricMotorwayEntry.SetText(FormatGermanNumber(a.currentProfile.ElectricConsumptionByRoad.Motorway, 1))
+	a.cityShareEntry.SetText(FormatGermanNumber(a.currentProfile.DrivingMix.City, 0))
+	a.ruralShareEntry.SetText(FormatGermanNumber(a.currentProfile.DrivingMix.Rural, 0))
+	a.motorwayShareEntry.SetText(FormatGermanNumber(a.currentProfile.DrivingMix.Motorway, 0))
 	a.purchasePriceEntry.SetText(FormatGermanNumber(a.currentProfile.PurchasePrice, 0))
+	a.odometerAtPurchaseEntry.SetText(FormatGermanNumber(a.currentProfile.OdometerAtPurchase, 0))
+	a.odometerAtSaleEntry.SetText(FormatGermanNumber(a.currentProfile.OdometerAtSale, 0))
 	a.ownershipYearsEntry.SetText(fmt.Sprintf("%d", a.currentProfile.ExpectedYearsOfOwnership))
+	a.drivingProfileCheck.SetChecked(a.currentProfile.DrivingProfile)
+	a.insuranceModelCheck.SetChecked(a.currentProfile.InsuranceModel)
+	a.insuranceCoverageSelect.SetSelected(a.translateInsuranceCoverage(string(a.currentProfile.InsuranceCoverage)))
+	a.updateChargingSourceRows()
+	a.updateCustomCostRows()
+	a.updateTripRows()
 }
 
 func (a *App) updateProfileFromForm() {
@@ -302,36 +1042,225 @@ func (a *App) updateProfileFromForm() {
 	if val, err := ParseGermanNumber(a.electricityPriceEntry.Text); err == nil {
 		a.currentProfile.ElectricityPrice = val
 	}
+	if val, err := ParseGermanNumber(a.chargingEfficiencyEntry.Text); err == nil {
+		a.currentProfile.ChargingEfficiency = val
+	}
 	if val, err := ParseGermanNumber(a.tankSizeEntry.Text); err == nil {
 		a.currentProfile.TankSize = val
 	}
 	if val, err := ParseGermanNumber(a.batterySizeEntry.Text); err == nil {
 		a.currentProfile.BatterySize = val
 	}
+	if val, err := ParseGermanNumber(a.batteryAgingPerYearEntry.Text); err == nil {
+		a.currentProfile.BatteryAgingPerYear = val
+	}
+	if val, err := ParseGermanNumber(a.batteryAgingPer100kEntry.Text); err == nil {
+		a.currentProfile.BatteryAgingPer100k = val
+	}
+	if val, err := ParseGermanNumber(a.batteryReplacementCostEntry.Text); err == nil {
+		a.currentProfile.BatteryReplacementCost = val
+	}
+	if val, err := ParseGermanNumber(a.batteryReplacementHealthEntry.Text); err == nil {
+		a.currentProfile.BatteryReplacementHealth = val
+	}
 	if val, err := ParseGermanNumber(a.monthlyKmEntry.Text); err == nil {
 		a.currentProfile.MonthlyKilometers = val
 	}
 	if val, err := ParseGermanNumber(a.annualTaxEntry.Text); err == nil {
 		a.currentProfile.AnnualCarTax = val
 	}
+	a.currentProfile.AutoCarTax = a.autoCarTaxCheck.Checked
+	if val, err := ParseGermanDate(a.firstRegistrationEntry.Text); err == nil {
+		a.currentProfile.FirstRegistration = val
+	}
+	if val, err := ParseGermanNumber(a.engineDisplacementEntry.Text); err == nil {
+		a.currentProfile.EngineDisplacement = val
+	}
+	if val, err := ParseGermanNumber(a.co2EmissionsEntry.Text); err == nil {
+		a.currentProfile.CO2Emissions = val
+	}
+	if val, err := ParseGermanNumber(a.vehicleWeightEntry.Text); err == nil {
+		a.currentProfile.VehicleWeight = val
+	}
 	if val, err := ParseGermanNumber(a.annualInsuranceEntry.Text); err == nil {
 		a.currentProfile.AnnualCarInsurance = val
 	}
+	if val, err := strconv.Atoi(a.insuranceSFClassEntry.Text); err == nil {
+		a.currentProfile.InsuranceSFClass = val
+	}
+	if val, err := ParseGermanNumber(a.insuranceLiabilityEntry.Text); err == nil {
+		a.currentProfile.InsuranceLiabilityPremium = val
+	}
+	if val, err := ParseGermanNumber(a.insurancePartialEntry.Text); err == nil {
+		a.currentProfile.InsurancePartialPremium = val
+	}
+	if val, err := ParseGermanNumber(a.insuranceFullEntry.Text); err == nil {
+		a.currentProfile.InsuranceFullPremium = val
+	}
+	if val, err := strconv.Atoi(a.insuranceSwitchEntry.Text); err == nil {
+		a.currentProfile.InsuranceSwitchYears = val
+	}
 	if val, err := ParseGermanNumber(a.financingRateEntry.Text); err == nil {
 		a.currentProfile.FinancingRate = val
 	}
 	if val, err := strconv.Atoi(a.financingPeriodEntry.Text); err == nil {
 		a.currentProfile.FinancingPeriod = val
 	}
+	if val, err := ParseGermanNumber(a.downPaymentEntry.Text); err == nil {
+		a.currentProfile.DownPayment = val
+	}
+	if val, err := ParseGermanNumber(a.interestRateEntry.Text); err == nil {
+		a.currentProfile.InterestRate = val
+	}
+	if val, err := ParseGermanNumber(a.balloonPaymentEntry.Text); err == nil {
+		a.currentProfile.BalloonPayment = val
+	}
+	if val, err := ParseGermanNumber(a.leaseSpecialPaymentEntry.Text); err == nil {
+		a.currentProfile.LeaseSpecialPayment = val
+	}
+	if val, err := ParseGermanNumber(a.leaseAnnualKmEntry.Text); err == nil {
+		a.currentProfile.LeaseAnnualKilometers = val
+	}
+	if val, err := ParseGermanNumber(a.leaseExcessKmCostEntry.Text); err == nil {
+		a.currentProfile.LeaseExcessKmCost = val
+	}
+	if val, err := ParseGermanNumber(a.leaseUnusedKmCreditEntry.Text); err == nil {
+		a.currentProfile.LeaseUnusedKmCredit = val
+	}
+	if val, err := ParseGermanNumber(a.depreciationRateEntry.Text); err == nil {
+		a.currentProfile.DepreciationRate = val
+	}
+	if val, err := ParseGermanNumber(a.expectedResaleValueEntry.Text); err == nil {
+		a.currentProfile.ExpectedResaleValue = val
+	}
+	if val, err := ParseGermanNumber(a.commuteDistanceEntry.Text); err == nil {
+		a.currentProfile.CommuteDistance = val
+	}
+	if val, err := ParseGermanNumber(a.privateUseShareEntry.Text); err == nil {
+		a.currentProfile.PrivateUseShare = val
+	}
+	if val, err := ParseGermanNumber(a.personalTaxRateEntry.Text); err == nil {
+		a.currentProfile.PersonalTaxRate = val
+	}
+	if val, err := ParseGermanNumber(a.fuelCityEntry.Text); err == nil {
+		a.currentProfile.FuelConsumptionByRoad.City = val
+	}
+	if val, err := ParseGermanNumber(a.fuelRuralEntry.Text); err == nil {
+		a.currentProfile.FuelConsumptionByRoad.Rural = val
+	}
+	if val, err := ParseGermanNumber(a.fuelMotorwayEntry.Text); err == nil {
+		a.currentProfile.FuelConsumptionByRoad.Motorway = val
+	}
+	if val, err := ParseGermanNumber(a.electricCityEntry.Text); err == nil {
+		a.currentProfile.ElectricConsumptionByRoad.City = val
+	}
+	if val, err := ParseGermanNumber(a.electricRuralEntry.Text); err == nil {
+		a.currentProfile.ElectricConsumptionByRoad.Rural = val
+	}
+	if val, err := ParseGermanNumber(a.electricMotorwayEntry.Text); err == nil {
+		a.currentProfile.ElectricConsumptionByRoad.Motorway = val
+	}
+	if val, err := ParseGermanNumber(a.cityShareEntry.Text); err == nil {
+		a.currentProfile.DrivingMix.City = val
+	}
+	if val, err := ParseGermanNumber(a.ruralShareEntry.Text); err == nil {
+		a.currentProfile.DrivingMix.Rural = val
+	}
+	if val, err := ParseGermanNumber(a.motorwayShareEntry.Text); err == nil {
+		a.currentProfile.DrivingMix.Motorway = val
+	}
+	if val, err := ParseGermanNumber(a.electricShareEntry.Text); err == nil {
+		a.currentProfile.ElectricShare = val
+	}
+	a.currentProfile.ElectricShareSet = strings.TrimSpace(a.electricShareEntry.Text) != ""
+	if val, err := ParseGermanNumber(a.dailyCommuteEntry.Text); err == nil {
+		a.currentProfile.DailyCommuteKm = val
+	}
+	if val, err := parseSeasonalFactors(a.seasonalFactorsEntry.Text); err == nil {
+		a.currentProfile.SeasonalFactors = val
+	}
+	if val, err := ParseGermanNumber(a.serviceCostEntry.Text); err == nil {
+		a.currentProfile.ServiceCost = val
+	}
+	if val, err := strconv.Atoi(a.serviceIntervalMonthsEntry.Text); err == nil {
+		a.currentProfile.ServiceIntervalMonths = val
+	}
+	if val, err := ParseGermanNumber(a.serviceIntervalKmEntry.Text); err == nil {
+		a.currentProfile.ServiceIntervalKm = val
+	}
+	if val, err := ParseGermanNumber(a.inspectionCostEntry.Text); err == nil {
+		a.currentProfile.InspectionCost = val
+	}
+	if val, err := strconv.Atoi(a.inspectionIntervalEntry.Text); err == nil {
+		a.currentProfile.InspectionIntervalMonths = val
+	}
+	if val, err := ParseGermanNumber(a.tireCostEntry.Text); err == nil {
+		a.currentProfile.TireCost = val
+	}
+	if val, err := ParseGermanNumber(a.tireLifetimeKmEntry.Text); err == nil {
+		a.currentProfile.TireLifetimeKm = val
+	}
+	if val, err := ParseGermanNumber(a.brakeCostEntry.Text); err == nil {
+		a.currentProfile.BrakeCost = val
+	}
+	if val, err := ParseGermanNumber(a.brakeIntervalKmEntry.Text); err == nil {
+		a.currentProfile.BrakeIntervalKm = val
+	}
+	if val, err := ParseGermanNumber(a.repairReserveEntry.Text); err == nil {
+		a.currentProfile.AnnualRepairReserve = val
+	}
+	if val, err := ParseGermanNumber(a.annualTHGRevenueEntry.Text); err == nil {
+		a.currentProfile.AnnualTHGRevenue = val
+	}
+	if val, err := ParseGermanNumber(a.purchaseIncentivesEntry.Text); err == nil {
+		a.currentProfile.PurchaseIncentives = val
+	}
 	if val, err := ParseGermanNumber(a.purchasePriceEntry.Text); err == nil {
 		a.currentProfile.PurchasePrice = val
 	}
+	if val, err := ParseGermanNumber(a.odometerAtPurchaseEntry.Text); err == nil {
+		a.currentProfile.OdometerAtPurchase = val
+	}
+	if val, err := ParseGermanNumber(a.odometerAtSaleEntry.Text); err == nil {
+		a.currentProfile.OdometerAtSale = val
+	}
 	if val, err := strconv.Atoi(a.ownershipYearsEntry.Text); err == nil {
 		a.currentProfile.ExpectedYearsOfOwnership = val
 	}
 
 	a.currentProfile.FuelType = models.FuelType(a.getFuelTypeFromTranslation(a.fuelTypeSelect.Selected))
 	a.currentProfile.ElectricityType = models.ElectricityType(a.getElectricityTypeFromTranslation(a.electricityTypeSelect.Selected))
+	a.currentProfile.AcquisitionType = models.AcquisitionType(a.getAcquisitionTypeFromTranslation(a.acquisitionTypeSelect.Selected))
+	a.currentProfile.DepreciationModel = models.DepreciationModelType(a.getDepreciationModelFromTranslation(a.depreciationModelSelect.Selected))
+	a.currentProfile.CompanyCar = a.companyCarCheck.Checked
+	a.currentProfile.DrivingProfile = a.drivingProfileCheck.Checked
+	a.currentProfile.InsuranceModel = a.insuranceModelCheck.Checked
+	a.currentProfile.InsuranceCoverage = models.InsuranceCoverage(a.getInsuranceCoverageFromTranslation(a.insuranceCoverageSelect.Selected))
+	a.currentProfile.CompanyCarMethod = models.CompanyCarMethod(a.getCompanyCarMethodFromTranslation(a.companyCarMethodSelect.Selected))
+}
+
+// displayedAcquisitionType maps profiles saved without an acquisition type
+// to cash or loan, depending on whether a financing is entered.
+func (a *App) displayedAcquisitionType() models.AcquisitionType {
+	if a.currentProfile.AcquisitionType != "" {
+		return a.currentProfile.AcquisitionType
+	}
+	if a.currentProfile.FinancingRate > 0 || a.currentProfile.FinancingPeriod > 0 {
+		return models.AcquisitionLoan
+	}
+	return models.AcquisitionCash
+}
+
+// updateFinancingForms shows the loan or lease inputs matching the acquisition type
+func (a *App) updateFinancingForms(acquisitionType models.AcquisitionType) {
+	a.loanForm.Hide()
+	a.leaseForm.Hide()
+	switch acquisitionType {
+	case models.AcquisitionLoan:
+		a.loanForm.Show()
+	case models.AcquisitionLease:
+		a.leaseForm.Show()
+	}
 }
 
 func (a *App) loadSelectedProfile(value string) {
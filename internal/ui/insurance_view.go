@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+	"fmt"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+)
+
+// germanCoverageNames are the German names of the insurance coverages
+var germanCoverageNames = map[models.InsuranceCoverage]string{
+	models.CoverageLiability:     "Haftpflicht",
+	models.CoveragePartial:       "Teilkasko",
+	models.CoverageComprehensive: "Vollkasko",
+}
+
+// formatInsuranceYear formats the SF class and coverage of a year of
+// ownership as "SF 12, Vollkasko".
+func formatInsuranceYear(premium models.InsuranceYear) string {
+	return fmt.Sprintf("SF %d, %s", premium.SFClass, germanCoverageNames[premium.Coverage])
+}
+
+// createInsuranceSummary lists the insurance premium of each year of
+// ownership with its SF class and coverage.
+func (a *App) createInsuranceSummary(calculation *models.CostCalculation) *fyne.Container {
+	content := container.NewVBox()
+	var total float64
+	for _, premium := range calculation.InsurancePremiums {
+		content.Add(widget.NewLabel(fmt.Sprintf("Jahr %d (%s): %s",
+			premium.Year, formatInsuranceYear(premium), FormatCurrency(premium.Premium))))
+		total += premium.Premium
+	}
+
+	content.Add(widget.NewSeparator())
+	content.Add(widget.NewRichTextFromMarkdown("**Gesamt: " + FormatCurrency(total) + "**"))
+	return content
+}
+
+// insurancePDFData returns the rows of the insurance section of the PDF export.
+func insurancePDFData(calculation *models.CostCalculation) [][]string {
+	var data [][]string
+	for _, premium := range calculation.InsurancePremiums {
+		data = append(data, []string{
+			fmt.Sprintf("Jahr %d (%s):", premium.Year, formatInsuranceYear(premium)),
+			FormatCurrencyPDF(premium.Premium),
+		})
+	}
+	return data
+}
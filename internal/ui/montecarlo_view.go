@@ -0,0 +1,206 @@
+package ui
+
+import (
+	"auto-unterhaltsrechner/internal/calculator"
+	"auto-unterhaltsrechner/internal/models"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+)
+
+func (a *App) showMonteCarloDialog() {
+	profiles, err := a.storage.ListProfiles()
+	if err != nil {
+		dialog.ShowError(err, a.window)
+		return
+	}
+
+	if len(profiles) < 2 {
+		dialog.ShowInformation("Nicht genügend Profile", "Für eine Monte-Carlo-Simulation werden mindestens 2 Profile benötigt.", a.window)
+		return
+	}
+
+	var profileList []string
+	profileMap := make(map[string]*models.CarProfile)
+	for _, profile := range profiles {
+		displayName := fmt.Sprintf("%s (%s)", profile.Name, profile.ID)
+		profileList = append(profileList, displayName)
+		profileMap[displayName] = profile
+	}
+
+	monteCarloWindow := a.fyneApp.NewWindow("Monte-Carlo-Simulation")
+	monteCarloWindow.Resize(fyne.NewSize(900, 750))
+
+	electricSelect := widget.NewSelect(profileList, nil)
+	combustionSelect := widget.NewSelect(profileList, nil)
+
+	// Preselect the first electric and the first combustion profile
+	for _, name := range profileList {
+		profile := profileMap[name]
+		if electricSelect.Selected == "" && profile.ElectricConsumption > 0 && profile.FuelConsumption == 0 {
+			electricSelect.SetSelected(name)
+		}
+		if combustionSelect.Selected == "" && profile.FuelConsumption > 0 {
+			combustionSelect.SetSelected(name)
+		}
+	}
+
+	settings := &a.settings.MonteCarlo
+	if settings.Iterations <= 0 {
+		*settings = models.DefaultMonteCarloSettings()
+	}
+
+	iterationsEntry := widget.NewEntry()
+	iterationsEntry.SetPlaceHolder("z.B. 5000")
+	iterationsEntry.SetText(fmt.Sprintf("%d", settings.Iterations))
+	iterationsEntry.OnChanged = func(text string) {
+		value, err := ParseGermanNumber(text)
+		if err != nil || value < 1 {
+			return // Invalid number, skip update
+		}
+		settings.Iterations = int(value)
+	}
+
+	uncertaintyForm := widget.NewForm(
+		a.createUncertainInputItem("Kraftstoffpreis", &settings.FuelPrice),
+		a.createUncertainInputItem("Strompreis", &settings.ElectricityPrice),
+		a.createUncertainInputItem("Fahrleistung", &settings.Kilometers),
+		a.createUncertainInputItem("Wiederverkaufswert", &settings.ResaleValue),
+		a.createUncertainInputItem("Reparaturkosten", &settings.RepairCosts),
+		widget.NewFormItem("Simulationen", iterationsEntry),
+	)
+
+	progressBar := widget.NewProgressBar()
+	resultsBox := container.NewVBox(widget.NewLabel("Starten Sie die Simulation, um die Kostenverteilung zu berechnen."))
+
+	var cancel context.CancelFunc
+	startButton := widget.NewButton("Simulation starten", nil)
+	cancelButton := widget.NewButton("Abbrechen", func() {
+		if cancel != nil {
+			cancel()
+		}
+	})
+	cancelButton.Disable()
+
+	startButton.OnTapped = func() {
+		electricProfile := profileMap[electricSelect.Selected]
+		combustionProfile := profileMap[combustionSelect.Selected]
+		if electricProfile == nil || combustionProfile == nil {
+			return
+		}
+
+		// Keep the settings for the next simulation
+		if err := a.storage.SaveSettings(a.settings); err != nil {
+			dialog.ShowError(err, monteCarloWindow)
+		}
+
+		// The simulation runs on its own calculator, so changing the
+		// settings meanwhile doesn't affect it
+		calc := calculator.New()
+		calc.SetAssumptions(a.settings.Assumptions)
+		runSettings := *settings
+
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.Background())
+		startButton.Disable()
+		cancelButton.Enable()
+		progressBar.SetValue(0)
+
+		go func() {
+			result, err := calc.RunMonteCarlo(ctx, electricProfile, combustionProfile, runSettings,
+				time.Now().UnixNano(), func(done, total int) {
+					fyne.Do(func() {
+						progressBar.SetValue(float64(done) / float64(total))
+					})
+				})
+
+			fyne.Do(func() {
+				startButton.Enable()
+				cancelButton.Disable()
+				resultsBox.RemoveAll()
+				switch {
+				case errors.Is(err, context.Canceled):
+					progressBar.SetValue(0)
+					resultsBox.Add(widget.NewLabel("Simulation abgebrochen."))
+				case err != nil:
+					dialog.ShowError(err, monteCarloWindow)
+				case result != nil:
+					a.showMonteCarloResult(resultsBox, result)
+				}
+			})
+		}()
+	}
+
+	// Stop a running simulation with the window
+	monteCarloWindow.SetOnClosed(func() {
+		if cancel != nil {
+			cancel()
+		}
+	})
+
+	selectionForm := widget.NewForm(
+		widget.NewFormItem("Elektrofahrzeug", electricSelect),
+		widget.NewFormItem("Verbrenner", combustionSelect),
+	)
+
+	content := container.NewVBox(
+		widget.NewCard("Fahrzeuge", "", selectionForm),
+		widget.NewCard("Unsicherheiten", "Streuung in % um den eingegebenen Wert", uncertaintyForm),
+		container.NewBorder(nil, nil, nil, container.NewHBox(startButton, cancelButton), progressBar),
+		widget.NewCard("Ergebnis", "", resultsBox),
+	)
+
+	monteCarloWindow.SetContent(container.NewScroll(content))
+	monteCarloWindow.Show()
+}
+
+// createUncertainInputItem returns a form item with the distribution and
+// the spread of an uncertain input.
+func (a *App) createUncertainInputItem(label string, input *models.UncertainInput) *widget.FormItem {
+	distributionSelect := widget.NewSelect(a.getTranslatedDistributionTypes(), func(value string) {
+		input.Distribution = models.DistributionType(a.getDistributionTypeFromTranslation(value))
+	})
+	distributionSelect.SetSelected(a.translateDistributionType(string(input.Distribution)))
+
+	spreadEntry := widget.NewEntry()
+	spreadEntry.SetPlaceHolder("z.B. 15")
+	spreadEntry.SetText(FormatGermanNumber(input.Spread, 0))
+	spreadEntry.OnChanged = func(text string) {
+		value, err := ParseGermanNumber(text)
+		if err != nil && text != "" {
+			return // Invalid number, skip update
+		}
+		input.Spread = value
+	}
+
+	return widget.NewFormItem(label, container.NewGridWithColumns(2, distributionSelect, spreadEntry))
+}
+
+func (a *App) showMonteCarloResult(content *fyne.Container, result *calculator.MonteCarloResult) {
+	for _, entry := range []struct {
+		name         string
+		distribution calculator.MonteCarloDistribution
+	}{
+		{result.ElectricProfile.Name, result.Electric},
+		{result.CombustionProfile.Name, result.Combustion},
+	} {
+		content.Add(widget.NewLabelWithStyle(entry.name, fyne.TextAlignLeading, fyne.TextStyle{Bold: true}))
+		content.Add(widget.NewLabel(fmt.Sprintf("Gesamtkosten der Nutzung: P10 %s, P50 %s, P90 %s",
+			FormatCurrency(entry.distribution.TCO.P10), FormatCurrency(entry.distribution.TCO.P50),
+			FormatCurrency(entry.distribution.TCO.P90))))
+		content.Add(widget.NewLabel(fmt.Sprintf("Kosten pro Kilometer: P10 %s €, P50 %s €, P90 %s €",
+			FormatGermanNumber(entry.distribution.CostPerKm.P10, 3), FormatGermanNumber(entry.distribution.CostPerKm.P50, 3),
+			FormatGermanNumber(entry.distribution.CostPerKm.P90, 3))))
+	}
+
+	content.Add(widget.NewSeparator())
+	content.Add(widget.NewRichTextFromMarkdown(fmt.Sprintf("**Wahrscheinlichkeit, dass %s günstiger ist: %s**",
+		result.ElectricProfile.Name, FormatPercentage(result.ElectricCheaperProbability))))
+	content.Add(widget.NewLabel(fmt.Sprintf("Basierend auf %d Simulationen. P10 bis P90 umfasst 80%% der Ergebnisse.", result.Iterations)))
+}
@@ -0,0 +1,35 @@
+package ui
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+	"fmt"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+)
+
+// createProjectionSummary lists the projected costs per year of ownership
+// including price escalation and the CO2 price path.
+func (a *App) createProjectionSummary(calculation *models.CostCalculation) *fyne.Container {
+	content := container.NewVBox()
+
+	for _, year := range calculation.YearlyProjection {
+		content.Add(widget.NewLabelWithStyle(fmt.Sprintf("Jahr %d: %s (kumuliert %s)",
+			year.Year, FormatCurrency(year.Total), FormatCurrency(year.Cumulative)),
+			fyne.TextAlignLeading, fyne.TextStyle{Bold: true}))
+
+		details := fmt.Sprintf("Energie %s, Steuer %s, Versicherung %s, Wartung %s",
+			FormatCurrency(year.Fuel+year.Electricity), FormatCurrency(year.Tax),
+			FormatCurrency(year.Insurance), FormatCurrency(year.Maintenance))
+		if year.Financing > 0 {
+			details += ", Finanzierung " + FormatCurrency(year.Financing)
+		}
+		if year.Battery > 0 {
+			details += ", Batterietausch " + FormatCurrency(year.Battery)
+		}
+		content.Add(widget.NewLabel(details))
+	}
+
+	return content
+}
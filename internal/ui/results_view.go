@@ -1,6 +1,9 @@
 package ui
 
 import (
+	"auto-unterhaltsrechner/internal/models"
+	"fmt"
+
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/widget"
@@ -20,6 +23,8 @@ func (a *App) updateResults() {
 		a.resultsView.Add(widget.NewCard("Berechnungsergebnisse", "", container.NewVBox(
 			widget.NewLabel("Wählen Sie ein Profil aus oder erstellen Sie ein neues, um die Kosten zu berechnen."),
 		)))
+		a.updateSensitivity()
+		a.updateHoldingPeriods()
 		return
 	}
 
@@ -27,46 +32,133 @@ func (a *App) updateResults() {
 	if calculation == nil {
 		return
 	}
+	a.applyAutoCarTax(calculation)
 
 	// Monthly costs section
 	monthlyCostsContent := container.NewVBox(
-		widget.NewLabel("Kraftstoffkosten: "+FormatCurrency(calculation.MonthlyFuelCost)),
-		widget.NewLabel("Stromkosten: "+FormatCurrency(calculation.MonthlyElectricityCost)),
-		widget.NewLabel("KFZ-Steuer: "+FormatCurrency(a.currentProfile.AnnualCarTax/12)),
-		widget.NewLabel("Versicherung: "+FormatCurrency(a.currentProfile.AnnualCarInsurance/12)),
-		widget.NewLabel("Finanzierung: "+FormatCurrency(a.currentProfile.FinancingRate)),
-		widget.NewSeparator(),
-		widget.NewRichTextFromMarkdown("**Gesamt: "+FormatCurrency(calculation.MonthlyRunningCosts)+"**"),
+		widget.NewLabel("Kraftstoffkosten: "+FormatCurrency(calculation.AnnualFuelCost/12)),
+		widget.NewLabel("Stromkosten: "+FormatCurrency(calculation.AnnualElectricityCost/12)),
+		widget.NewLabel("KFZ-Steuer: "+FormatCurrency(calculation.AnnualTaxCost/12)),
+		widget.NewLabel("Versicherung: "+FormatCurrency(calculation.AnnualInsuranceCost/12)),
+		widget.NewLabel("Inspektion: "+FormatCurrency(calculation.MonthlyServiceCost)),
+		widget.NewLabel("HU/AU: "+FormatCurrency(calculation.MonthlyInspectionCost)),
+		widget.NewLabel("Reifen: "+FormatCurrency(calculation.MonthlyTireCost)),
+		widget.NewLabel("Bremsen: "+FormatCurrency(calculation.MonthlyBrakeCost)),
+		widget.NewLabel("Reparaturrücklage: "+FormatCurrency(calculation.MonthlyRepairReserve)),
+		widget.NewLabel("Finanzierung: "+FormatCurrency(calculation.MonthlyFinancingCost)),
 	)
+	a.addCustomCostLabels(monthlyCostsContent, calculation, 1)
+	if batteryCost := firstYearBatteryCost(calculation); batteryCost > 0 {
+		monthlyCostsContent.Add(widget.NewLabel("Batterietausch: " + FormatCurrency(batteryCost/12)))
+	}
+	if calculation.MonthlyRevenue > 0 {
+		monthlyCostsContent.Add(widget.NewLabel("Gutschrift THG-Quote: -" + FormatCurrency(calculation.MonthlyRevenue)))
+	}
+	monthlyCostsContent.Add(widget.NewSeparator())
+	monthlyCostsContent.Add(widget.NewRichTextFromMarkdown("**Gesamt: " + FormatCurrency(calculation.MonthlyRunningCosts) + "**"))
+	if calculation.FinancingMonths > 0 && calculation.FinancingMonths < len(calculation.Timeline) {
+		monthlyCostsContent.Add(widget.NewLabel(fmt.Sprintf("Gesamt ab Monat %d (nach Finanzierungsende): %s",
+			calculation.FinancingMonths+1, FormatCurrency(calculation.MonthlyCostsAfterFinancing))))
+	}
 
 	// Annual costs section
 	annualCostsContent := container.NewVBox(
 		widget.NewLabel("Kraftstoffkosten: "+FormatCurrency(calculation.AnnualFuelCost)),
 		widget.NewLabel("Stromkosten: "+FormatCurrency(calculation.AnnualElectricityCost)),
-		widget.NewLabel("KFZ-Steuer: "+FormatCurrency(a.currentProfile.AnnualCarTax)),
-		widget.NewLabel("Versicherung: "+FormatCurrency(a.currentProfile.AnnualCarInsurance)),
-		widget.NewLabel("Finanzierung: "+FormatCurrency(a.currentProfile.FinancingRate*12)),
-		widget.NewSeparator(),
-		widget.NewRichTextFromMarkdown("**Gesamt: "+FormatCurrency(calculation.AnnualRunningCosts)+"**"),
+		widget.NewLabel("KFZ-Steuer: "+FormatCurrency(calculation.AnnualTaxCost)),
+		widget.NewLabel("Versicherung: "+FormatCurrency(calculation.AnnualInsuranceCost)),
+		widget.NewLabel("Inspektion: "+FormatCurrency(calculation.MonthlyServiceCost*12)),
+		widget.NewLabel("HU/AU: "+FormatCurrency(calculation.MonthlyInspectionCost*12)),
+		widget.NewLabel("Reifen: "+FormatCurrency(calculation.MonthlyTireCost*12)),
+		widget.NewLabel("Bremsen: "+FormatCurrency(calculation.MonthlyBrakeCost*12)),
+		widget.NewLabel("Reparaturrücklage: "+FormatCurrency(calculation.MonthlyRepairReserve*12)),
+		widget.NewLabel("Finanzierung: "+FormatCurrency(calculation.AnnualFinancingCost)),
 	)
+	a.addCustomCostLabels(annualCostsContent, calculation, 12)
+	if batteryCost := firstYearBatteryCost(calculation); batteryCost > 0 {
+		annualCostsContent.Add(widget.NewLabel("Batterietausch: " + FormatCurrency(batteryCost)))
+	}
+	if calculation.AnnualRevenue > 0 {
+		annualCostsContent.Add(widget.NewLabel("Gutschrift THG-Quote: -" + FormatCurrency(calculation.AnnualRevenue)))
+	}
+	if !calculation.TaxExemptUntil.IsZero() {
+		annualCostsContent.Add(widget.NewLabel("KFZ-steuerbefreit bis " + FormatGermanDate(calculation.TaxExemptUntil)))
+	}
+	annualCostsContent.Add(widget.NewSeparator())
+	annualCostsContent.Add(widget.NewRichTextFromMarkdown("**Gesamt: " + FormatCurrency(calculation.AnnualRunningCosts) + "**"))
 
 	// Depreciation section
 	depreciationContent := container.NewVBox(
 		widget.NewLabel("Gesamter Wertverlust: "+FormatCurrency(calculation.TotalDepreciation)),
 		widget.NewLabel("Jährlicher Wertverlust: "+FormatCurrency(calculation.AnnualDepreciation)),
 	)
+	if calculation.VehicleAgeAtPurchase > 0 || calculation.Profile.OdometerAtPurchase > 0 {
+		depreciationContent.Add(widget.NewLabel(fmt.Sprintf("Fahrzeugalter bei Kauf: %s Jahre, bei Verkauf: %s Jahre",
+			FormatGermanNumber(calculation.VehicleAgeAtPurchase, 1), FormatGermanNumber(calculation.VehicleAgeAtSale, 1))))
+		depreciationContent.Add(widget.NewLabel("Kilometerstand bei Kauf: " + FormatKilometers(calculation.Profile.OdometerAtPurchase)))
+	}
+	depreciationContent.Add(widget.NewLabel("Kilometerstand bei Verkauf: " + FormatKilometers(calculation.OdometerAtSale)))
+	if len(calculation.ResidualValues) > 0 {
+		depreciationContent.Add(widget.NewSeparator())
+		for i, value := range calculation.ResidualValues {
+			depreciationContent.Add(widget.NewLabel(fmt.Sprintf("Restwert nach Jahr %d: %s", i+1, FormatCurrency(value))))
+		}
+	}
+	if a.currentProfile.AcquisitionType == models.AcquisitionLease {
+		depreciationContent = container.NewVBox(
+			widget.NewLabel("Beim Leasing trägt der Leasinggeber den Wertverlust."),
+		)
+	}
 
 	// Key metrics section
 	keyMetricsContent := container.NewVBox(
-		widget.NewLabel("Kosten pro Kilometer: "+FormatCurrency(calculation.CostPerKilometer)),
-		widget.NewLabel("Gesamtkosten der Nutzung: "+FormatCurrency(calculation.TotalCostOfOwnership)),
+		widget.NewLabel("Zahlung bei Kauf: " + FormatCurrency(calculation.UpfrontCosts)),
 	)
+	if calculation.PurchaseIncentives > 0 {
+		keyMetricsContent.Add(widget.NewLabel("Gutschrift Kaufprämien: -" + FormatCurrency(calculation.PurchaseIncentives)))
+	}
+	keyMetricsContent.Add(widget.NewLabel("Kosten pro Kilometer: " + FormatCurrency(calculation.CostPerKilometer)))
+	keyMetricsContent.Add(widget.NewLabel("Gesamtkosten der Nutzung: " + FormatCurrency(calculation.TotalCostOfOwnership)))
+	if calculation.TotalCostOfOwnership != calculation.NominalCostOfOwnership {
+		keyMetricsContent.Add(widget.NewLabel("davon Kapitalkosten: " + FormatCurrency(calculation.CapitalCost)))
+	}
+	if rate := a.settings.Assumptions.DiscountRate; rate != 0 {
+		keyMetricsContent.Add(widget.NewLabel(fmt.Sprintf("Barwert der Gesamtkosten (%s): %s",
+			FormatPercentage(rate), FormatCurrency(calculation.PresentCostOfOwnership))))
+		if !a.settings.Assumptions.IncludeCapitalCost {
+			keyMetricsContent.Add(widget.NewLabel("Kapitalkosten (entgangene Zinsen): " + FormatCurrency(calculation.CapitalCost)))
+		}
+	}
+	keyMetricsContent.Add(widget.NewLabel("Äquivalente jährliche Kosten: " + FormatCurrency(calculation.EquivalentAnnualCost)))
+	for _, cost := range calculation.CustomCostBreakdown {
+		if cost.Recurrence == models.RecurrenceOnce {
+			keyMetricsContent.Add(widget.NewLabel(cost.Name + " (einmalig): " + FormatCurrency(cost.OneOff)))
+		}
+	}
 
 	// Consumption information
 	consumptionContent := container.NewVBox()
 
-	if a.currentProfile.FuelConsumption > 0 {
-		monthlyFuelAmount := (a.currentProfile.FuelConsumption * a.currentProfile.MonthlyKilometers) / 100
+	if mix := calculation.DrivingMix; mix.Total() > 0 {
+		consumptionContent.Add(widget.NewLabel(fmt.Sprintf("Fahrprofil: Stadt %s, Landstraße %s, Autobahn %s",
+			FormatPercentage(mix.City), FormatPercentage(mix.Rural), FormatPercentage(mix.Motorway))))
+		consumptionContent.Add(widget.NewLabel("Monatliche Kilometer: " + FormatKilometers(calculation.Profile.MonthlyKilometers)))
+		if calculation.Profile.FuelConsumption > 0 {
+			consumptionContent.Add(widget.NewLabel("Gewichteter Kraftstoffverbrauch: " +
+				FormatConsumption(calculation.Profile.FuelConsumption, "L")))
+		}
+		if calculation.Profile.ElectricConsumption > 0 {
+			consumptionContent.Add(widget.NewLabel("Gewichteter Stromverbrauch: " +
+				FormatConsumption(calculation.Profile.ElectricConsumption, "kWh")))
+		}
+	}
+
+	if calculation.Profile.FuelConsumption > 0 && calculation.Profile.ElectricConsumption > 0 {
+		consumptionContent.Add(widget.NewLabel("Elektrischer Fahranteil: " + FormatPercentage(calculation.ElectricShare)))
+	}
+
+	if calculation.Profile.FuelConsumption > 0 {
+		monthlyFuelAmount := calculation.MonthlyFuelAmount
 		annualFuelAmount := monthlyFuelAmount * 12
 		consumptionContent.Add(widget.NewLabel("Monatlicher Kraftstoffverbrauch: " + FormatLiters(monthlyFuelAmount)))
 		consumptionContent.Add(widget.NewLabel("Jährlicher Kraftstoffverbrauch: " + FormatLiters(annualFuelAmount)))
@@ -77,11 +169,14 @@ func (a *App) updateResults() {
 		}
 	}
 
-	if a.currentProfile.ElectricConsumption > 0 {
-		monthlyElectricAmount := (a.currentProfile.ElectricConsumption * a.currentProfile.MonthlyKilometers) / 100
+	if calculation.Profile.ElectricConsumption > 0 {
+		monthlyElectricAmount := calculation.MonthlyElectricityAmount
 		annualElectricAmount := monthlyElectricAmount * 12
-		consumptionContent.Add(widget.NewLabel("Monatlicher Stromverbrauch: " + FormatKWh(monthlyElectricAmount)))
-		consumptionContent.Add(widget.NewLabel("Jährlicher Stromverbrauch: " + FormatKWh(annualElectricAmount)))
+		consumptionContent.Add(widget.NewLabel("Monatlicher Stromverbrauch (Batterie): " + FormatKWh(monthlyElectricAmount)))
+		consumptionContent.Add(widget.NewLabel("Jährlicher Stromverbrauch (Batterie): " + FormatKWh(annualElectricAmount)))
+		consumptionContent.Add(widget.NewLabel("Monatlicher Strombezug aus dem Netz: " + FormatKWh(calculation.MonthlyGridEnergy)))
+		consumptionContent.Add(widget.NewLabel("Jährlicher Strombezug aus dem Netz: " + FormatKWh(calculation.MonthlyGridEnergy*12)))
+		consumptionContent.Add(widget.NewLabel("Ladeverluste pro Monat: " + FormatKWh(calculation.MonthlyGridEnergy-monthlyElectricAmount)))
 
 		if a.currentProfile.BatterySize > 0 {
 			chargesPerMonth := monthlyElectricAmount / a.currentProfile.BatterySize
@@ -89,25 +184,69 @@ func (a *App) updateResults() {
 		}
 	}
 
+	// CO2 emissions from fuel and grid energy
+	emissionsContent := container.NewVBox()
+	if calculation.MonthlyCO2 > 0 {
+		if calculation.MonthlyFuelCO2 > 0 && calculation.MonthlyElectricityCO2 > 0 {
+			emissionsContent.Add(widget.NewLabel("Monatlich aus Kraftstoff: " + FormatCO2(calculation.MonthlyFuelCO2)))
+			emissionsContent.Add(widget.NewLabel("Monatlich aus Strom: " + FormatCO2(calculation.MonthlyElectricityCO2)))
+		}
+		emissionsContent.Add(widget.NewLabel("Monatliche Emissionen: " + FormatCO2(calculation.MonthlyCO2)))
+		emissionsContent.Add(widget.NewLabel("Jährliche Emissionen: " + FormatCO2(calculation.AnnualCO2)))
+		emissionsContent.Add(widget.NewLabel("Emissionen über Haltedauer: " + FormatCO2(calculation.LifetimeCO2)))
+		if calculation.Profile.MonthlyKilometers > 0 {
+			perKm := calculation.MonthlyCO2 / calculation.Profile.MonthlyKilometers * 1000
+			emissionsContent.Add(widget.NewLabel("Emissionen pro Kilometer: " + FormatGermanNumber(perKm, 0) + " g CO2/km"))
+		}
+	}
+
 	// Range information
 	rangeContent := container.NewVBox()
 
-	if a.currentProfile.TankSize > 0 && a.currentProfile.FuelConsumption > 0 {
-		fuelRange := (a.currentProfile.TankSize / a.currentProfile.FuelConsumption) * 100
+	if a.currentProfile.TankSize > 0 && calculation.Profile.FuelConsumption > 0 {
+		fuelRange := (a.currentProfile.TankSize / calculation.Profile.FuelConsumption) * 100
 		rangeContent.Add(widget.NewLabel("Reichweite mit vollem Tank: " + FormatKilometers(fuelRange)))
 	}
 
-	if a.currentProfile.BatterySize > 0 && a.currentProfile.ElectricConsumption > 0 {
-		electricRange := (a.currentProfile.BatterySize / a.currentProfile.ElectricConsumption) * 100
+	if a.currentProfile.BatterySize > 0 && calculation.Profile.ElectricConsumption > 0 {
+		electricRange := (a.currentProfile.BatterySize / calculation.Profile.ElectricConsumption) * 100
 		rangeContent.Add(widget.NewLabel("Elektrische Reichweite: " + FormatKilometers(electricRange)))
+		a.addBatteryHealthLabels(rangeContent, calculation)
 	}
 
-	// Update results view
+	// Update results view. The costs of a company car are borne by the
+	// employer, the driver pays the tax on the benefit from the net salary.
 	a.resultsView.RemoveAll()
-	a.resultsView.Add(widget.NewCard("Monatliche Kosten", "", monthlyCostsContent))
-	a.resultsView.Add(widget.NewCard("Jährliche Kosten", "", annualCostsContent))
+	monthlyTitle, annualTitle, keyMetricsTitle := "Monatliche Kosten", "Jährliche Kosten", "Kennzahlen"
+	if a.currentProfile.CompanyCar {
+		a.resultsView.Add(widget.NewCard("Dienstwagen", "Ihre Kosten", a.createCompanyCarSummary(calculation)))
+		monthlyTitle = "Monatliche Fahrzeugkosten (Arbeitgeber)"
+		annualTitle = "Jährliche Fahrzeugkosten (Arbeitgeber)"
+		keyMetricsTitle = "Kennzahlen des Fahrzeugs (Arbeitgeber)"
+	}
+	a.resultsView.Add(widget.NewCard(monthlyTitle, "", monthlyCostsContent))
+	a.resultsView.Add(widget.NewCard(annualTitle, "", annualCostsContent))
+
+	if len(calculation.AmortizationSchedule) > 0 {
+		a.resultsView.Add(widget.NewCard("Finanzierung", "", a.createLoanSummary(calculation)))
+	}
+	if a.currentProfile.AcquisitionType == models.AcquisitionLease {
+		a.resultsView.Add(widget.NewCard("Leasing", "", a.createLeaseSummary(calculation)))
+	}
+	if calculation.MonthlyElectricityAmount > 0 {
+		a.resultsView.Add(widget.NewCard("Lademix", "", a.createChargingSummary(calculation)))
+	}
+	if len(calculation.SeasonalProfile) == 12 && calculation.AnnualFuelCost+calculation.AnnualElectricityCost > 0 {
+		a.resultsView.Add(widget.NewCard("Energiekosten im Jahresverlauf", "", a.createSeasonalSummary(calculation)))
+	}
+	if a.currentProfile.InsuranceModel && len(calculation.InsurancePremiums) > 0 {
+		a.resultsView.Add(widget.NewCard("Versicherungsbeiträge", "", a.createInsuranceSummary(calculation)))
+	}
+	if len(calculation.YearlyProjection) > 1 {
+		a.resultsView.Add(widget.NewCard("Kostenprognose", "", a.createProjectionSummary(calculation)))
+	}
 	a.resultsView.Add(widget.NewCard("Wertverlust", "", depreciationContent))
-	a.resultsView.Add(widget.NewCard("Kennzahlen", "", keyMetricsContent))
+	a.resultsView.Add(widget.NewCard(keyMetricsTitle, "", keyMetricsContent))
 
 	if consumptionContent.Objects != nil && len(consumptionContent.Objects) > 0 {
 		a.resultsView.Add(widget.NewCard("Verbrauch", "", consumptionContent))
@@ -116,4 +255,38 @@ func (a *App) updateResults() {
 	if rangeContent.Objects != nil && len(rangeContent.Objects) > 0 {
 		a.resultsView.Add(widget.NewCard("Reichweite", "", rangeContent))
 	}
+
+	if len(emissionsContent.Objects) > 0 {
+		a.resultsView.Add(widget.NewCard("CO2-Emissionen", "", emissionsContent))
+	}
+
+	a.updateSensitivity()
+	a.updateHoldingPeriods()
+}
+
+// addCustomCostLabels adds a line per recurring custom cost item, scaled to
+// the given number of months.
+func (a *App) addCustomCostLabels(content *fyne.Container, calculation *models.CostCalculation, months float64) {
+	for _, cost := range calculation.CustomCostBreakdown {
+		if cost.Recurrence == models.RecurrenceOnce {
+			continue
+		}
+		content.Add(widget.NewLabel(cost.Name + ": " + FormatCurrency(cost.Monthly*months)))
+	}
+}
+
+// applyAutoCarTax fills the tax entry with the calculated tax of the first
+// year if the tax is calculated automatically.
+func (a *App) applyAutoCarTax(calculation *models.CostCalculation) {
+	if !a.currentProfile.AutoCarTax || a.annualTaxEntry == nil {
+		return
+	}
+
+	a.currentProfile.AnnualCarTax = calculation.AnnualTaxCost
+
+	// Don't feed the calculated value back through OnChanged
+	onChanged := a.annualTaxEntry.OnChanged
+	a.annualTaxEntry.OnChanged = nil
+	a.annualTaxEntry.SetText(FormatGermanNumber(calculation.AnnualTaxCost, 0))
+	a.annualTaxEntry.OnChanged = onChanged
 }
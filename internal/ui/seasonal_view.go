@@ -0,0 +1,139 @@
+package ui
+
+import (
+	"auto-unterhaltsrechner/internal/models"
+	"fmt"
+	"sort"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+)
+
+// germanMonthNames are the calendar month names indexed by time.Month
+var germanMonthNames = [...]string{"", "Januar", "Februar", "März", "April", "Mai", "Juni",
+	"Juli", "August", "September", "Oktober", "November", "Dezember"}
+
+// formatSeasonalFactors formats the monthly consumption factors as
+// "125; 122; 110; ...", no factors as empty string.
+func formatSeasonalFactors(factors []float64) string {
+	parts := make([]string, 0, len(factors))
+	for _, factor := range factors {
+		parts = append(parts, FormatGermanNumber(factor, 0))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// parseSeasonalFactors parses the factors formatted by
+// formatSeasonalFactors. An empty text returns no factors, i.e. the
+// defaults of the powertrain.
+func parseSeasonalFactors(text string) ([]float64, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, nil
+	}
+
+	var factors []float64
+	for _, part := range strings.Split(text, ";") {
+		factor, err := ParseGermanNumber(strings.TrimSpace(part))
+		if err != nil {
+			return nil, fmt.Errorf("invalid seasonal factor %q: %w", part, err)
+		}
+		if factor < 0 {
+			return nil, fmt.Errorf("negative seasonal factor %q", part)
+		}
+		factors = append(factors, factor)
+	}
+
+	if len(factors) != 12 {
+		return nil, fmt.Errorf("expected 12 seasonal factors, got %d", len(factors))
+	}
+	return factors, nil
+}
+
+// seasonalExtremes returns the months with the lowest and highest energy
+// costs and the average energy cost per month.
+func seasonalExtremes(months []models.SeasonalMonth) (best, worst models.SeasonalMonth, average float64) {
+	if len(months) == 0 {
+		return
+	}
+
+	best, worst = months[0], months[0]
+	for _, month := range months {
+		if month.EnergyCost < best.EnergyCost {
+			best = month
+		}
+		if month.EnergyCost > worst.EnergyCost {
+			worst = month
+		}
+		average += month.EnergyCost
+	}
+	average /= float64(len(months))
+	return best, worst, average
+}
+
+// formatSeasonalMonth formats the energy cost and use of a calendar month.
+func formatSeasonalMonth(month models.SeasonalMonth) string {
+	text := germanMonthNames[month.Month] + ": " + FormatCurrency(month.EnergyCost)
+
+	var amounts []string
+	if month.FuelAmount > 0 {
+		amounts = append(amounts, FormatLiters(month.FuelAmount))
+	}
+	if month.ElectricityAmount > 0 {
+		amounts = append(amounts, FormatKWh(month.ElectricityAmount))
+	}
+	if len(amounts) > 0 {
+		text += " (" + strings.Join(amounts, ", ") + ")"
+	}
+	return text
+}
+
+// createSeasonalSummary shows the energy costs of the most and the least
+// expensive month of the first year next to the average month.
+func (a *App) createSeasonalSummary(calculation *models.CostCalculation) *fyne.Container {
+	best, worst, average := seasonalExtremes(calculation.SeasonalProfile)
+
+	content := container.NewVBox(
+		widget.NewLabel("Teuerster Monat: "+formatSeasonalMonth(worst)),
+		widget.NewLabel("Günstigster Monat: "+formatSeasonalMonth(best)),
+		widget.NewLabel("Durchschnitt pro Monat: "+FormatCurrency(average)),
+	)
+	if average > 0 {
+		content.Add(widget.NewLabel(fmt.Sprintf("Schwankung: %s bis +%s gegenüber dem Durchschnitt",
+			FormatPercentage((best.EnergyCost/average-1)*100), FormatPercentage((worst.EnergyCost/average-1)*100))))
+	}
+
+	content.Add(widget.NewSeparator())
+	months := append([]models.SeasonalMonth(nil), calculation.SeasonalProfile...)
+	sortSeasonalMonths(months)
+	for _, month := range months {
+		content.Add(widget.NewLabel(formatSeasonalMonth(month)))
+	}
+
+	return content
+}
+
+// sortSeasonalMonths orders the months from January to December.
+func sortSeasonalMonths(months []models.SeasonalMonth) {
+	sort.Slice(months, func(i, j int) bool {
+		return months[i].Month < months[j].Month
+	})
+}
+
+// seasonalPDFData returns the rows of the seasonal section of the PDF export.
+func seasonalPDFData(calculation *models.CostCalculation) [][]string {
+	best, worst, average := seasonalExtremes(calculation.SeasonalProfile)
+	data := [][]string{
+		{"Teuerster Monat:", germanMonthNames[worst.Month] + ", " + FormatCurrencyPDF(worst.EnergyCost)},
+		{"Günstigster Monat:", germanMonthNames[best.Month] + ", " + FormatCurrencyPDF(best.EnergyCost)},
+		{"Durchschnitt pro Monat:", FormatCurrencyPDF(average)},
+	}
+
+	months := append([]models.SeasonalMonth(nil), calculation.SeasonalProfile...)
+	sortSeasonalMonths(months)
+	for _, month := range months {
+		data = append(data, []string{germanMonthNames[month.Month] + ":", FormatCurrencyPDF(month.EnergyCost)})
+	}
+	return data
+}
@@ -0,0 +1,173 @@
+package ui
+
+import (
+	"auto-unterhaltsrechner/internal/calculator"
+	"fmt"
+	"math"
+	"sort"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+	"github.com/jung-kurt/gofpdf"
+)
+
+// Metrics the sensitivity chart can be ranked by
+const (
+	sensitivityMetricTCO       = "Gesamtkosten der Nutzung"
+	sensitivityMetricCostPerKm = "Kosten pro Kilometer"
+)
+
+// defaultSensitivityVariation is the default input variation in %
+const defaultSensitivityVariation = 10.0
+
+func (a *App) createSensitivityView() fyne.CanvasObject {
+	a.sensitivityVariation = defaultSensitivityVariation
+	a.sensitivityMetric = sensitivityMetricTCO
+
+	variationEntry := widget.NewEntry()
+	variationEntry.SetPlaceHolder("z.B. 10")
+	variationEntry.SetText(FormatGermanNumber(a.sensitivityVariation, 0))
+	variationEntry.OnChanged = func(text string) {
+		value, err := ParseGermanNumber(text)
+		if err != nil || value <= 0 || value >= 100 {
+			return // Invalid variation, skip update
+		}
+		a.sensitivityVariation = value
+		a.updateSensitivity()
+	}
+
+	metricSelect := widget.NewSelect([]string{sensitivityMetricTCO, sensitivityMetricCostPerKm}, func(value string) {
+		a.sensitivityMetric = value
+		a.updateSensitivity()
+	})
+	metricSelect.SetSelected(a.sensitivityMetric)
+
+	a.sensitivityChart = newTornadoChart()
+	a.sensitivityDetails = container.NewVBox()
+
+	controls := widget.NewForm(
+		widget.NewFormItem("Variation (± %)", variationEntry),
+		widget.NewFormItem("Kennzahl", metricSelect),
+	)
+
+	return container.NewVBox(
+		widget.NewCard("Sensitivitätsanalyse", "Wie stark ändern sich die Kosten, wenn einzelne Eingaben abweichen?", controls),
+		a.sensitivityChart,
+		a.sensitivityDetails,
+	)
+}
+
+// updateSensitivity recalculates the sensitivity analysis of the current
+// profile and redraws the tornado chart.
+func (a *App) updateSensitivity() {
+	if a.sensitivityChart == nil || a.sensitivityDetails == nil {
+		return
+	}
+
+	a.sensitivityDetails.RemoveAll()
+	if a.currentProfile == nil {
+		a.sensitivityChart.SetData(0, nil, "", "")
+		return
+	}
+
+	analysis := a.calculator.CalculateSensitivity(a.currentProfile, a.sensitivityVariation)
+	if analysis == nil {
+		return
+	}
+
+	byCostPerKm := a.sensitivityMetric == sensitivityMetricCostPerKm
+	results := sortSensitivityResults(analysis.Results, byCostPerKm)
+
+	base := analysis.BaseTCO
+	format := FormatCurrency
+	a.sensitivityChart.FormatX = func(v float64) string { return FormatGermanNumber(v, 0) + " €" }
+	if byCostPerKm {
+		base = analysis.BaseCostPerKm
+		format = func(v float64) string { return FormatGermanNumber(v, 3) + " €/km" }
+		a.sensitivityChart.FormatX = format
+	}
+	a.sensitivityDetails.Add(widget.NewRichTextFromMarkdown("**Ausgangswert: " + format(base) + "**"))
+
+	bars := make([]tornadoBar, 0, len(results))
+	for _, result := range results {
+		bar := tornadoBar{Label: result.Parameter, Low: result.LowTCO, High: result.HighTCO}
+		if byCostPerKm {
+			bar.Low, bar.High = result.LowCostPerKm, result.HighCostPerKm
+		}
+		bars = append(bars, bar)
+
+		a.sensitivityDetails.Add(widget.NewLabel(fmt.Sprintf("%s: %s bis %s", result.Parameter,
+			format(bar.Low), format(bar.High))))
+	}
+
+	variation := FormatGermanNumber(analysis.Variation, 0)
+	a.sensitivityChart.SetData(base, bars, "-"+variation+" %", "+"+variation+" %")
+}
+
+// sortSensitivityResults returns the results ranked by the swing of the
+// selected metric.
+func sortSensitivityResults(results []calculator.SensitivityResult, byCostPerKm bool) []calculator.SensitivityResult {
+	sorted := append([]calculator.SensitivityResult(nil), results...)
+	if byCostPerKm {
+		sort.SliceStable(sorted, func(i, j int) bool {
+			return sorted[i].CostPerKmSwing() > sorted[j].CostPerKmSwing()
+		})
+	}
+	return sorted
+}
+
+// drawTornadoPDF draws the TCO tornado chart of the analysis below the
+// current position of the PDF.
+func drawTornadoPDF(pdf *gofpdf.Fpdf, analysis *calculator.SensitivityAnalysis) {
+	const (
+		labelWidth = 50.0
+		plotWidth  = 130.0
+		rowHeight  = 6.0
+		barHeight  = 4.0
+	)
+
+	left, _, _, bottomMargin := pdf.GetMargins()
+	_, pageHeight := pdf.GetPageSize()
+	height := float64(len(analysis.Results))*rowHeight + 8
+	if pdf.GetY()+height > pageHeight-bottomMargin-20 {
+		pdf.AddPage()
+	}
+
+	minX, maxX := analysis.BaseTCO, analysis.BaseTCO
+	for _, result := range analysis.Results {
+		minX = math.Min(minX, math.Min(result.LowTCO, result.HighTCO))
+		maxX = math.Max(maxX, math.Max(result.LowTCO, result.HighTCO))
+	}
+	if maxX == minX {
+		maxX = minX + 1
+	}
+	toX := func(x float64) float64 {
+		return left + labelWidth + (x-minX)/(maxX-minX)*plotWidth
+	}
+
+	top := pdf.GetY()
+	baseX := toX(analysis.BaseTCO)
+	pdf.SetFont("Arial", "", 8)
+	for i, result := range analysis.Results {
+		y := top + float64(i)*rowHeight
+		pdf.SetXY(left, y)
+		pdf.CellFormat(labelWidth-2, rowHeight, result.Parameter, "", 0, "R", false, 0, "")
+
+		for _, bar := range []struct {
+			value float64
+			color []int
+		}{{result.LowTCO, []int{52, 152, 219}}, {result.HighTCO, []int{142, 68, 173}}} {
+			x := toX(bar.value)
+			pdf.SetFillColor(bar.color[0], bar.color[1], bar.color[2])
+			pdf.Rect(math.Min(x, baseX), y+(rowHeight-barHeight)/2, math.Abs(x-baseX), barHeight, "F")
+		}
+	}
+
+	bottom := top + float64(len(analysis.Results))*rowHeight
+	pdf.Line(baseX, top, baseX, bottom)
+	pdf.SetXY(left+labelWidth, bottom+1)
+	pdf.CellFormat(plotWidth/2, 5, FormatCurrencyPDF(minX), "", 0, "L", false, 0, "")
+	pdf.CellFormat(plotWidth/2, 5, FormatCurrencyPDF(maxX), "", 1, "R", false, 0, "")
+	pdf.Ln(6)
+}
@@ -57,28 +57,121 @@ const (
 
 	TooltipElectricityType = "Art der Stromversorgung. Haushaltsstrom ist meist günstiger als öffentliche Ladestationen."
 
+	TooltipChargingMix = "Verteilung des Ladens auf mehrere Ladearten (z.B. Wallbox, Arbeitgeber, DC-Schnelllader) " +
+		"mit eigenem Preis und Anteil an der geladenen Energie. Ersetzt Strompreis und Stromart, sobald eine Ladeart eingetragen ist."
+
+	TooltipChargingEfficiency = "Anteil der aus dem Netz bezogenen Energie, der in der Batterie ankommt, in Prozent. " +
+		"Ohne Angabe: Haushaltssteckdose 80%, Wallbox und öffentliche AC-Ladesäule 88%, DC-Schnelllader 95%."
+
 	TooltipTankSize = "Volumen des Kraftstofftanks in Litern. Wird für die Berechnung der Reichweite verwendet."
 
 	TooltipBatterySize = "Kapazität der Fahrzeugbatterie in kWh. Wird für die Berechnung der elektrischen Reichweite verwendet."
 
+	TooltipBatteryAging = "Verlust an Batteriekapazität (State of Health) in Prozent pro Jahr seit Erstzulassung und pro " +
+		"100.000 km. Leer lassen für 1 % pro Jahr und 4 % pro 100.000 km. Die Reichweite sinkt entsprechend, " +
+		"Plug-in-Hybride fahren bei abgeleitetem Fahranteil mehr mit Kraftstoff."
+
+	TooltipBatteryReplacement = "Kosten eines Batterietauschs in Euro. Fällt der Batteriezustand unter den Schwellwert " +
+		"(Standard 70 %), wird die Batterie getauscht und die Kosten fließen in die Gesamtkosten ein. Leer lassen für keinen Tausch."
+
 	TooltipMonthlyKilometers = "Durchschnittlich gefahrene Kilometer pro Monat. " +
 		"Grundlage für alle Kostenberechnungen. Kann aus dem Jahreskilometerstand ÷ 12 ermittelt werden."
 
+	TooltipElectricShare = "Anteil der Kilometer, die ein Plug-in-Hybrid elektrisch fährt, in Prozent. " +
+		"Kraftstoff- und Stromkosten werden nur für ihren Anteil der Strecke berechnet. " +
+		"Leer lassen, um ihn aus der Pendelstrecke abzuleiten; ohne Pendelstrecke gelten 45%."
+
+	TooltipDailyCommuteKm = "Tägliche Pendelstrecke in Kilometern. Ohne Fahranteil wird dieser aus elektrischer Reichweite " +
+		"und Pendelstrecke abgeleitet (einmal Laden pro Arbeitstag, übrige Fahrten mit Kraftstoff)."
+
+	TooltipSeasonalFactors = "Verbrauch je Kalendermonat von Januar bis Dezember in Prozent, getrennt durch Semikolon. " +
+		"Die Werte werden auf einen Jahresdurchschnitt von 100 % normiert, der eingegebene Verbrauch bleibt der " +
+		"Jahresdurchschnitt. Leer lassen für typische Werte nach Antrieb (Elektroauto im Winter bis +25 %)."
+
 	TooltipAnnualTax = "Jährliche KFZ-Steuer in Euro. Der Betrag steht im Steuerbescheid oder " +
-		"kann online beim Kraftfahrt-Bundesamt berechnet werden."
+		"wird bei aktivierter automatischer Berechnung aus den Fahrzeugdaten ermittelt."
+
+	TooltipAutoCarTax = "Berechnet die KFZ-Steuer aus Erstzulassung, Kraftstoffart, Hubraum und CO2-Ausstoß nach dem " +
+		"Kraftfahrzeugsteuergesetz. Elektroautos mit Erstzulassung bis 31.12.2030 sind zehn Jahre, längstens bis 31.12.2035 " +
+		"steuerbefreit, danach wird nach Gesamtgewicht besteuert. Zum Überschreiben das Häkchen entfernen."
 
 	TooltipAnnualInsurance = "Jährliche Kosten für die Fahrzeugversicherung in Euro. " +
 		"Umfasst Haftpflicht, Teil- oder Vollkasko je nach gewähltem Versicherungsschutz."
 
+	TooltipInsuranceModel = "Berechnet die Versicherung aus den Beiträgen für Haftpflicht und Kasko in der SF-Klasse bei Kauf. " +
+		"Mit jedem schadenfreien Jahr steigt die SF-Klasse und Haftpflicht und Vollkasko werden günstiger, die Teilkasko bleibt gleich. " +
+		"Optional wird nach einigen Jahren von Vollkasko auf Teilkasko gewechselt; 0 für keinen Wechsel."
+
 	TooltipFinancingRate = "Monatliche Rate für Finanzierung oder Leasing in Euro. " +
 		"Bei Barkauf 0 eingeben."
 
 	TooltipFinancingPeriod = "Laufzeit der Finanzierung oder des Leasings in Monaten. " +
 		"Bei Barkauf 0 eingeben."
 
+	TooltipDownPayment = "Anzahlung bei Kreditfinanzierung in Euro. Der Kreditbetrag ergibt sich aus Kaufpreis minus Anzahlung. " +
+		"Sobald Anzahlung, Effektivzins oder Schlussrate angegeben sind, wird die Monatsrate berechnet."
+
+	TooltipInterestRate = "Effektiver Jahreszins des Kredits in Prozent, wie er im Kreditangebot ausgewiesen ist."
+
+	TooltipBalloonPayment = "Schlussrate am Ende der Laufzeit in Euro (z.B. bei Ballonfinanzierung). " +
+		"Ohne Schlussrate 0 eingeben."
+
+	TooltipAcquisitionType = "Art des Fahrzeugerwerbs: Barkauf, Kredit oder Leasing. " +
+		"Bei Leasing entfällt der Wertverlust, stattdessen werden Leasingsonderzahlung, Raten und der Kilometerausgleich berechnet."
+
+	TooltipLeaseSpecialPayment = "Einmalige Leasingsonderzahlung zu Vertragsbeginn in Euro."
+
+	TooltipLeaseAnnualKilometers = "Im Leasingvertrag vereinbarte Fahrleistung pro Jahr in Kilometern."
+
+	TooltipLeaseExcessKmCost = "Preis je gefahrenem Mehrkilometer über der vereinbarten Fahrleistung in Euro."
+
+	TooltipLeaseUnusedKmCredit = "Vergütung je nicht gefahrenem Minderkilometer in Euro. Ohne Vergütung 0 eingeben."
+
 	TooltipPurchasePrice = "Kaufpreis des Fahrzeugs in Euro. Wird für die Wertverlustkalkulation verwendet. " +
 		"Bei Gebrauchtwagen den tatsächlich gezahlten Preis eingeben."
 
+	TooltipOdometer = "Kilometerstand bei Kauf, bei Neuwagen leer lassen. Zusammen mit der Erstzulassung bestimmt er, wo " +
+		"ein Gebrauchtwagen auf der Wertverlustkurve startet, wie stark die Wartung mit dem Alter steigt und den Batteriezustand. " +
+		"Der erwartete Kilometerstand bei Verkauf geht in den Restwert ein; leer lassen, um ihn aus den monatlichen Kilometern zu berechnen."
+
+	TooltipServiceCost = "Kosten einer Inspektion in Euro. Fällig nach Zeit- oder Kilometerintervall, je nachdem was zuerst erreicht wird."
+
+	TooltipServiceInterval = "Inspektionsintervall laut Serviceheft in Monaten und Kilometern. Ohne Angabe wird jährlich gerechnet."
+
+	TooltipInspectionCost = "Kosten der Hauptuntersuchung mit Abgasuntersuchung (HU/AU) in Euro. Ohne Intervall alle 24 Monate."
+
+	TooltipTireCost = "Kosten eines Reifensatzes inklusive Montage in Euro. Wird über die Reifenlaufleistung (Standard 40.000 km) verteilt."
+
+	TooltipBrakeCost = "Kosten für Bremsbeläge und -scheiben in Euro. Wird über das Bremsenintervall (Standard 60.000 km) verteilt."
+
+	TooltipAnnualRepairReserve = "Jährliche Rücklage für unvorhergesehene Reparaturen in Euro."
+
+	TooltipAnnualTHGRevenue = "Jährlicher Erlös aus dem Verkauf der THG-Quote in Euro. Wird als Gutschrift von den laufenden Kosten abgezogen."
+
+	TooltipPurchaseIncentives = "Förderungen und Händlerboni beim Kauf in Euro. Werden von der Zahlung bei Kauf abgezogen."
+
+	TooltipDepreciationModel = "Modell für den Wertverlust: linear auf 20% Restwert, degressiv mit festem Prozentsatz pro Jahr, " +
+		"nach Alter und Laufleistung oder bis zum selbst geschätzten Wiederverkaufswert."
+
+	TooltipDepreciationRate = "Jährlicher Wertverlust in Prozent des Restwerts beim degressiven Modell. Ohne Angabe werden 15% angenommen."
+
+	TooltipExpectedResaleValue = "Erwarteter Verkaufserlös am Ende der Besitzdauer in Euro, z.B. aus einer Online-Bewertung."
+
+	TooltipCompanyCar = "Dienstwagen mit privater Nutzung. Versteuert wird der geldwerte Vorteil; als Bruttolistenpreis " +
+		"wird der Kaufpreis verwendet."
+
+	TooltipCompanyCarMethod = "Pauschal: 1% des Bruttolistenpreises pro Monat, bei Elektroautos 0,25% (bis 100.000 €) bzw. 0,5%, " +
+		"bei Plug-in-Hybriden mit höchstens 50 g/km CO2 oder 80 km elektrischer Reichweite 0,5%. " +
+		"Fahrtenbuch: Privatanteil der tatsächlichen Gesamtkosten."
+
+	TooltipCommuteDistance = "Einfache Entfernung zwischen Wohnung und erster Tätigkeitsstätte in Kilometern. " +
+		"Zuschlag von 0,03% des Listenpreises je Entfernungskilometer und Monat."
+
+	TooltipPrivateUseShare = "Anteil der Privatfahrten inklusive Fahrten zur Arbeit an der Gesamtfahrleistung laut Fahrtenbuch."
+
+	TooltipPersonalTaxRate = "Persönlicher Grenzsteuersatz inklusive Solidaritätszuschlag und Kirchensteuer, " +
+		"mit dem der geldwerte Vorteil versteuert wird."
+
 	TooltipOwnershipYears = "Geplante Besitzdauer des Fahrzeugs in Jahren. " +
 		"Beeinflusst die Berechnung des jährlichen Wertverlusts."
 )
@@ -4,8 +4,11 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 )
 
+const germanDateLayout = "02.01.2006"
+
 func FormatGermanNumber(value float64, decimals int) string {
 	formatted := fmt.Sprintf("%."+strconv.Itoa(decimals)+"f", value)
 
@@ -44,6 +47,18 @@ func ParseGermanNumber(str string) (float64, error) {
 	return strconv.ParseFloat(cleaned, 64)
 }
 
+// FormatGermanDate formats a date as TT.MM.JJJJ, a zero date as empty string
+func FormatGermanDate(date time.Time) string {
+	if date.IsZero() {
+		return ""
+	}
+	return date.Format(germanDateLayout)
+}
+
+func ParseGermanDate(str string) (time.Time, error) {
+	return time.Parse(germanDateLayout, strings.TrimSpace(str))
+}
+
 func FormatCurrency(value float64) string {
 	return FormatGermanNumber(value, 2) + " €"
 }
@@ -64,6 +79,14 @@ func FormatKWh(value float64) string {
 	return FormatGermanNumber(value, 1) + " kWh"
 }
 
+// FormatCO2 formats CO2 emissions given in kg, large amounts in tonnes
+func FormatCO2(value float64) string {
+	if value >= 1000 {
+		return FormatGermanNumber(value/1000, 2) + " t CO2"
+	}
+	return FormatGermanNumber(value, 1) + " kg CO2"
+}
+
 func FormatConsumption(value float64, unit string) string {
 	return FormatGermanNumber(value, 1) + " " + unit + "/100km"
 }